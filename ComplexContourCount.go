@@ -0,0 +1,103 @@
+// ComplexContourCount
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    辐角原理求圆周内解析（亚纯）函数的零点个数
+理论：
+    f在圆周C: |z - z0| = r上无零点与极点，C内零点个数Z与极点
+    个数P（均计重数）满足辐角原理：
+              1       f'(z)        1
+    Z - P = ------ ∮ ------- dz = ---- Δ_C arg f(z)
+             2πi   C  f(z)         2π
+
+    将C等分为m段，累加各段辐角增量
+    Δ arg = arg(f(z_(j+1))/f(zj)) ∈ (-π, π]
+    以该段中点zm检验：两半段增量绝对值均不大于π/4且其和等于
+    全段增量时接受，否则二分该段（至多30次），以免辐角增量
+    被遗漏。f解析时即为零点个数。
+------------------------------------------------------
+输入   :
+    fn      f(z)函数
+    z0      圆心
+    r       半径
+    m       初始等分数
+输出   :
+    sol     零点个数减极点个数
+    err     解出标志：false-圆周上或其附近有零点（极点）；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// ComplexContourCount 辐角原理求圆周内解析（亚纯）函数的零点个数
+func ComplexContourCount(fn func(complex128) complex128, z0 complex128,
+	r float64, m int) (int, bool) {
+	/*
+		辐角原理求圆周内解析（亚纯）函数的零点个数
+		输入   :
+		    fn      f(z)函数
+		    z0      圆心
+		    r       半径
+		    m       初始等分数
+		输出   :
+		    sol     零点个数减极点个数
+		    err     解出标志：false-圆周上或其附近有零点（极点）；
+		                     true-全部解出
+	*/
+	//判断半径与等分数
+	if (r <= 0.0) || (m < 3) {
+		panic("Error in goNum.ComplexContourCount: Radius or subdivision number error")
+	}
+
+	var err bool = true
+	var sum float64
+	point := func(t float64) complex128 { return z0 + cmplx.Rect(r, t) }
+	//累加[t0, t1]段的辐角增量
+	var arc func(t0, t1 float64, f0, f1 complex128, depth int)
+	arc = func(t0, t1 float64, f0, f1 complex128, depth int) {
+		if (f0 == 0) || (f1 == 0) || cmplx.IsNaN(f0) || cmplx.IsNaN(f1) ||
+			cmplx.IsInf(f0) || cmplx.IsInf(f1) {
+			err = false
+			return
+		}
+		tm := (t0 + t1) / 2.0
+		fm := fn(point(tm))
+		da := cmplx.Phase(f1 / f0)
+		d1 := cmplx.Phase(fm / f0)
+		d2 := cmplx.Phase(f1 / fm)
+		//两半段增量之和与全段一致时接受
+		if (math.Abs(d1) <= math.Pi/4.0) && (math.Abs(d2) <= math.Pi/4.0) &&
+			(math.Abs(d1+d2-da) <= 1e-10) {
+			sum += da
+			return
+		}
+		if depth >= 30 {
+			err = false
+			sum += d1 + d2
+			return
+		}
+		arc(t0, tm, f0, fm, depth+1)
+		arc(tm, t1, fm, f1, depth+1)
+	}
+	h := 2.0 * math.Pi / float64(m)
+	fa := fn(point(0.0))
+	f0 := fa
+	for j := 0; j < m; j++ {
+		f1 := fa
+		if j < m-1 {
+			f1 = fn(point(float64(j+1) * h))
+		}
+		arc(float64(j)*h, float64(j+1)*h, f0, f1, 0)
+		f0 = f1
+	}
+	return int(math.Round(sum / (2.0 * math.Pi))), err
+}
@@ -0,0 +1,199 @@
+// ComplexContourRoots
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    围道积分求圆周内解析函数的全部零点
+理论：
+    f在圆|z - z0| <= r上解析，圆周上无零点，C内零点z1, ..., zN
+    （N由ComplexContourCount求得），记w = (z - z0)/r，其k阶矩：
+            1       k f'(z)      N
+    sk = ------ ∮ w ------- dz = Sum wj^k，k = 1, ..., N
+          2πi   C    f(z)       j=1
+    z = z0 + r*e^(iθ)，以m点复化梯形公式计算（周期函数，指数
+    收敛）：
+           1   m-1   ikθj f'(zj)
+    sk ~= --- Sum  e    ------- r*e^(iθj)，θj = 2πj/m
+           m   j=0       f(zj)
+    由Newton恒等式求初等对称多项式：
+    e0 = 1，k*ek = Sum_(i=1)^k (-1)^(i-1) e_(k-i) si
+    wj为 w^N - e1*w^(N-1) + e2*w^(N-2) - ... + (-1)^N eN = 0
+    的根，以Aberth-Ehrlich同时迭代求出，再以ComplexNewton修正
+    zj = z0 + r*wj。fn1为nil时f'由中心差分近似。
+
+    N较大或零点靠近圆周时矩的误差增大，宜缩小半径分区域求解。
+    求传递函数的极点可对1/f求零点。
+
+    参考 L.M. Delves and J.N. Lyness. A numerical method for
+         locating the zeros of an analytic function. Math.
+         Comp., 1967, 21(100): 543-560.
+------------------------------------------------------
+输入   :
+    fn      f(z)函数
+    fn1     f'(z)函数，为nil时差分近似
+    z0      圆心
+    r       半径
+    m       积分节点数，应远大于零点个数
+    tol     修正零点的误差上限
+输出   :
+    sol     全部零点（计重数），按实部、虚部升序
+    err     解出标志：false-未解出或修正未收敛；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+)
+
+//Aberth-Ehrlich同时迭代求首一复系数多项式sum c[i]w^i的全部根
+func aberth_ComplexContourRoots(c []complex128) []complex128 {
+	n := len(c) - 1
+	//Horner格式求p(w)与p'(w)
+	eval := func(w complex128) (complex128, complex128) {
+		p := c[n]
+		var dp complex128
+		for i := n - 1; i >= 0; i-- {
+			dp = dp*w + p
+			p = p*w + c[i]
+		}
+		return p, dp
+	}
+	w := make([]complex128, n)
+	for k := range w {
+		w[k] = cmplx.Rect(1.0, 2.0*math.Pi*float64(k)/float64(n)+0.4)
+	}
+	done := make([]bool, n)
+	for iter := 0; iter < 500; iter++ {
+		conv := true
+		for k := 0; k < n; k++ {
+			if done[k] {
+				continue
+			}
+			p, dp := eval(w[k])
+			if p == 0 {
+				done[k] = true
+				continue
+			}
+			q := p / dp
+			var s complex128
+			for j := 0; j < n; j++ {
+				if j != k {
+					s += 1.0 / (w[k] - w[j])
+				}
+			}
+			dw := q / (1.0 - q*s)
+			if cmplx.IsNaN(dw) || cmplx.IsInf(dw) {
+				dw = q
+			}
+			w[k] -= dw
+			if cmplx.Abs(dw) <= 4.0*2.220446049250313e-16*math.Max(cmplx.Abs(w[k]), 1.0) {
+				done[k] = true
+			} else {
+				conv = false
+			}
+		}
+		if conv {
+			break
+		}
+	}
+	return w
+}
+
+// ComplexContourRoots 围道积分求圆周内解析函数的全部零点
+func ComplexContourRoots(fn, fn1 func(complex128) complex128, z0 complex128,
+	r float64, m int, tol float64) ([]complex128, bool) {
+	/*
+		围道积分求圆周内解析函数的全部零点
+		输入   :
+		    fn      f(z)函数
+		    fn1     f'(z)函数，为nil时差分近似
+		    z0      圆心
+		    r       半径
+		    m       积分节点数，应远大于零点个数
+		    tol     修正零点的误差上限
+		输出   :
+		    sol     全部零点（计重数），按实部、虚部升序
+		    err     解出标志：false-未解出或修正未收敛；
+		                     true-全部解出
+	*/
+	//判断半径与节点数
+	if (r <= 0.0) || (m < 3) {
+		panic("Error in goNum.ComplexContourRoots: Radius or node number error")
+	}
+	if fn1 == nil {
+		fn1 = func(z complex128) complex128 { return diff_ComplexNewton(fn, z) }
+	}
+
+	sol := make([]complex128, 0)
+	var err bool = false
+	N, errtemp := ComplexContourCount(fn, z0, r, m)
+	if (errtemp != true) || (N < 0) {
+		return sol, err
+	}
+	if N == 0 {
+		err = true
+		return sol, err
+	}
+
+	//1. 梯形公式求矩s1, ..., sN
+	s := make([]complex128, N+1)
+	for j := 0; j < m; j++ {
+		e := cmplx.Rect(1.0, 2.0*math.Pi*float64(j)/float64(m))
+		z := z0 + complex(r, 0)*e
+		g := fn1(z) / fn(z) * complex(r, 0) * e
+		ek := complex(1.0, 0)
+		for k := 1; k < N+1; k++ {
+			ek *= e
+			s[k] += ek * g
+		}
+	}
+	for k := 1; k < N+1; k++ {
+		s[k] /= complex(float64(m), 0)
+	}
+
+	//2. Newton恒等式
+	el := make([]complex128, N+1)
+	el[0] = 1
+	for k := 1; k < N+1; k++ {
+		var temp0 complex128
+		sign := 1.0
+		for i := 1; i < k+1; i++ {
+			temp0 += complex(sign, 0) * el[k-i] * s[i]
+			sign = -sign
+		}
+		el[k] = temp0 / complex(float64(k), 0)
+	}
+	c := make([]complex128, N+1)
+	sign := 1.0
+	for k := 0; k < N+1; k++ {
+		c[N-k] = complex(sign, 0) * el[k]
+		sign = -sign
+	}
+
+	//3. 求根并修正
+	err = true
+	for _, w := range aberth_ComplexContourRoots(c) {
+		z := z0 + complex(r, 0)*w
+		zt, errtemp := ComplexNewton(fn, fn1, z, tol, 100)
+		if (errtemp == true) && (cmplx.Abs(zt-z0) <= r) {
+			z = zt
+		} else {
+			err = false
+		}
+		sol = append(sol, z)
+	}
+	sort.Slice(sol, func(i, j int) bool {
+		if real(sol[i]) != real(sol[j]) {
+			return real(sol[i]) < real(sol[j])
+		}
+		return imag(sol[i]) < imag(sol[j])
+	})
+	return sol, err
+}
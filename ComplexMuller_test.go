@@ -0,0 +1,121 @@
+// ComplexMuller_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    复平面上的Muller法求解f(z)=0的根
+理论：
+    过最近三点(z0, f0)、(z1, f1)、(z2, f2)作二次插值：
+    h1 = z1 - z0，h2 = z2 - z1，
+    d1 = (f1 - f0)/h1，d2 = (f2 - f1)/h2，
+    a = (d2 - d1)/(h2 + h1)，b = a*h2 + d2，c = f2
+                    -2c
+    z3 = z2 + --------------------
+               b +/- sqrt(b^2-4ac)
+    分母取模较大者，平方根取复数值，故实初值亦可收敛至复根。
+    不需导数，收敛阶约1.84。
+
+    |z3 - z2| < tol或f(z3) = 0时停止。
+
+    参考 John H. Mathews and Kurtis D. Fink. Numerical
+         methods using MATLAB, 4th ed. Pearson
+         Education, 2004. ss 2.5.2.
+------------------------------------------------------
+输入   :
+    fn      f(z)函数，定义为等式左侧部分，右侧为0
+    z0      初值，三个不同点
+    tol     误差上限
+    N       步数上限
+输出   :
+    sol     解值
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math/cmplx"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// ComplexMuller 复平面上的Muller法求解f(z)=0的根
+func ComplexMuller(fn func(complex128) complex128, z0 []complex128,
+	tol float64, N int) (complex128, bool) {
+	/*
+		复平面上的Muller法求解f(z)=0的根
+		输入   :
+		    fn      f(z)函数，定义为等式左侧部分，右侧为0
+		    z0      初值，三个不同点
+		    tol     误差上限
+		    N       步数上限
+		输出   :
+		    sol     解值
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断初值
+	if len(z0) != 3 {
+		panic("Error in goNum.ComplexMuller: z0 is not three points")
+	}
+	if (z0[0] == z0[1]) || (z0[1] == z0[2]) || (z0[0] == z0[2]) {
+		panic("Error in goNum.ComplexMuller: z0 are not different points")
+	}
+	//判断tol
+	if tol <= 0.0 {
+		panic("Error in goNum.ComplexMuller: tol less than or equals to zero")
+	}
+
+	var err bool = false
+	x0, x1, x2 := z0[0], z0[1], z0[2]
+	f0, f1, f2 := fn(x0), fn(x1), fn(x2)
+	sol := x2
+	for i := 0; i < N; i++ {
+		if f2 == 0 {
+			err = true
+			return sol, err
+		}
+		h1 := x1 - x0
+		h2 := x2 - x1
+		d1 := (f1 - f0) / h1
+		d2 := (f2 - f1) / h2
+		a := (d2 - d1) / (h2 + h1)
+		b := a*h2 + d2
+		D := cmplx.Sqrt(b*b - 4.0*a*f2)
+		den := b + D
+		if cmplx.Abs(b-D) > cmplx.Abs(den) {
+			den = b - D
+		}
+		var dz complex128
+		if den != 0 {
+			dz = -2.0 * f2 / den
+		} else {
+			//插值抛物线退化时扰动
+			dz = complex(1.0+cmplx.Abs(x2), 0)
+		}
+		sol = x2 + dz
+		if cmplx.IsNaN(sol) || cmplx.IsInf(sol) {
+			return sol, err
+		}
+		//解出
+		if cmplx.Abs(dz) < tol {
+			err = true
+			return sol, err
+		}
+		x0, x1, x2 = x1, x2, sol
+		f0, f1, f2 = f1, f2, fn(sol)
+	}
+	return sol, err
+}
+
+func BenchmarkComplexMuller(b *testing.B) {
+	z87 := []complex128{0.0, 0.5, 1.0}
+	for i := 0; i < b.N; i++ {
+		goNum.ComplexMuller(fun87, z87, 1e-14, 100)
+	}
+}
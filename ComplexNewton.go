@@ -0,0 +1,95 @@
+// ComplexNewton
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    复平面上的Newton法求解解析函数f(z)=0的根
+理论：
+    z_(k+1) = zk - f(zk)/f'(zk)
+
+    f解析时复导数与方向无关，fn1为nil时以中心差分近似：
+              f(z + h) - f(z - h)
+    f'(z) ~= ---------------------，h = eps^(1/3)*max(|z|, 1)
+                      2h
+    单根附近平方收敛，实初值可收敛至复根（初值需有非零虚部
+    时方能进入复平面，实系数函数的实初值迭代保持为实数）。
+
+    |z_(k+1) - zk| < tol或f(z_(k+1)) = 0时停止。
+------------------------------------------------------
+输入   :
+    fn      f(z)函数，定义为等式左侧部分，右侧为0
+    fn1     f'(z)函数，为nil时差分近似
+    z0      初值
+    tol     误差上限
+    N       步数上限
+输出   :
+    sol     解值
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+//中心差分求复导数
+func diff_ComplexNewton(fn func(complex128) complex128, z complex128) complex128 {
+	h := complex(math.Cbrt(2.220446049250313e-16)*math.Max(cmplx.Abs(z), 1.0), 0)
+	return (fn(z+h) - fn(z-h)) / (2.0 * h)
+}
+
+// ComplexNewton 复平面上的Newton法求解解析函数f(z)=0的根
+func ComplexNewton(fn, fn1 func(complex128) complex128, z0 complex128,
+	tol float64, N int) (complex128, bool) {
+	/*
+		复平面上的Newton法求解解析函数f(z)=0的根
+		输入   :
+		    fn      f(z)函数，定义为等式左侧部分，右侧为0
+		    fn1     f'(z)函数，为nil时差分近似
+		    z0      初值
+		    tol     误差上限
+		    N       步数上限
+		输出   :
+		    sol     解值
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断tol
+	if tol <= 0.0 {
+		panic("Error in goNum.ComplexNewton: tol less than or equals to zero")
+	}
+	if fn1 == nil {
+		fn1 = func(z complex128) complex128 { return diff_ComplexNewton(fn, z) }
+	}
+
+	var err bool = false
+	sol := z0
+	for i := 0; i < N; i++ {
+		f := fn(sol)
+		if f == 0 {
+			err = true
+			return sol, err
+		}
+		df := fn1(sol)
+		if df == 0 {
+			return sol, err
+		}
+		dz := f / df
+		sol -= dz
+		if cmplx.IsNaN(sol) || cmplx.IsInf(sol) {
+			return sol, err
+		}
+		//解出
+		if cmplx.Abs(dz) < tol {
+			err = true
+			return sol, err
+		}
+	}
+	return sol, err
+}
@@ -0,0 +1,81 @@
+// ConvergenceOrder
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    离散格式的收敛阶验证（已知精确解或构造解）
+理论：
+    在网格加密序列n0 < n1 < ... < nk上运行求解器，由精确解
+    （或构造解）给出各级误差ej = E(nj)，ej ~ C*nj^(-p)，则观测
+    收敛阶为：
+          log(e_(j-1)/ej)
+    pj = -----------------, j = 1, 2, ..., k
+          log(nj/n_(j-1))
+
+    funerr(n)一般为以n为网格数量（或步数）运行求解器，并以
+    MaxError、PDEGrid.ErrorNorm等计算的误差。对p阶格式，
+    pj -> p；误差达到舍入误差水平后pj不再有意义。
+    无精确解时可使用ConvergenceRichardson。
+------------------------------------------------------
+输入   :
+    funerr  误差函数，网格数量n -> 误差E(n)
+    ns      加密序列，递增
+输出   :
+    sol     结果矩阵，3xk，第一行为n，第二行为误差，第三行为
+            观测收敛阶（第一列为0）
+    err     解出标志：false-误差非正或非有限值；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+// ConvergenceOrder 离散格式的收敛阶验证（已知精确解或构造解）
+func ConvergenceOrder(funerr func(int) float64, ns []int) (Matrix, bool) {
+	/*
+		离散格式的收敛阶验证（已知精确解或构造解）
+		输入   :
+		    funerr  误差函数，网格数量n -> 误差E(n)
+		    ns      加密序列，递增
+		输出   :
+		    sol     结果矩阵，3xk，第一行为n，第二行为误差，第三行为
+		            观测收敛阶（第一列为0）
+		    err     解出标志：false-误差非正或非有限值；
+		                     true-全部解出
+	*/
+	k := len(ns)
+	//判断加密序列
+	if k < 2 {
+		panic("Error in goNum.ConvergenceOrder: At least two levels are needed")
+	}
+	for j := 1; j < k; j++ {
+		if ns[j] <= ns[j-1] {
+			panic("Error in goNum.ConvergenceOrder: ns is not increasing")
+		}
+	}
+
+	var err bool = true
+	sol := ZeroMatrix(3, k)
+	for j := 0; j < k; j++ {
+		e := funerr(ns[j])
+		sol.SetMatrix(0, j, float64(ns[j]))
+		sol.SetMatrix(1, j, e)
+		if !(e > 0.0) || math.IsInf(e, 0) {
+			err = false
+			continue
+		}
+		if j > 0 {
+			e0 := sol.GetFromMatrix(1, j-1)
+			if e0 > 0.0 {
+				sol.SetMatrix(2, j, math.Log(e0/e)/math.Log(float64(ns[j])/float64(ns[j-1])))
+			}
+		}
+	}
+	return sol, err
+}
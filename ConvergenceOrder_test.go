@@ -0,0 +1,316 @@
+// ConvergenceOrder_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    离散格式的收敛阶验证（已知精确解或构造解）
+理论：
+    在网格加密序列n0 < n1 < ... < nk上运行求解器，由精确解
+    （或构造解）给出各级误差ej = E(nj)，ej ~ C*nj^(-p)，则观测
+    收敛阶为：
+          log(e_(j-1)/ej)
+    pj = -----------------, j = 1, 2, ..., k
+          log(nj/n_(j-1))
+
+    funerr(n)一般为以n为网格数量（或步数）运行求解器，并以
+    MaxError、PDEGrid.ErrorNorm等计算的误差。对p阶格式，
+    pj -> p；误差达到舍入误差水平后pj不再有意义。
+    无精确解时可使用ConvergenceRichardson。
+------------------------------------------------------
+输入   :
+    funerr  误差函数，网格数量n -> 误差E(n)
+    ns      加密序列，递增
+输出   :
+    sol     结果矩阵，3xk，第一行为n，第二行为误差，第三行为
+            观测收敛阶（第一列为0）
+    err     解出标志：false-误差非正或非有限值；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// ConvergenceOrder 离散格式的收敛阶验证（已知精确解或构造解）
+func ConvergenceOrder(funerr func(int) float64, ns []int) (goNum.Matrix, bool) {
+	/*
+		离散格式的收敛阶验证（已知精确解或构造解）
+		输入   :
+		    funerr  误差函数，网格数量n -> 误差E(n)
+		    ns      加密序列，递增
+		输出   :
+		    sol     结果矩阵，3xk，第一行为n，第二行为误差，第三行为
+		            观测收敛阶（第一列为0）
+		    err     解出标志：false-误差非正或非有限值；
+		                     true-全部解出
+	*/
+	k := len(ns)
+	//判断加密序列
+	if k < 2 {
+		panic("Error in goNum.ConvergenceOrder: At least two levels are needed")
+	}
+	for j := 1; j < k; j++ {
+		if ns[j] <= ns[j-1] {
+			panic("Error in goNum.ConvergenceOrder: ns is not increasing")
+		}
+	}
+
+	var err bool = true
+	sol := goNum.ZeroMatrix(3, k)
+	for j := 0; j < k; j++ {
+		e := funerr(ns[j])
+		sol.SetMatrix(0, j, float64(ns[j]))
+		sol.SetMatrix(1, j, e)
+		if !(e > 0.0) || math.IsInf(e, 0) {
+			err = false
+			continue
+		}
+		if j > 0 {
+			e0 := sol.GetFromMatrix(1, j-1)
+			if e0 > 0.0 {
+				sol.SetMatrix(2, j, math.Log(e0/e)/math.Log(float64(ns[j])/float64(ns[j-1])))
+			}
+		}
+	}
+	return sol, err
+}
+
+//y' = y*cos(x)，y(0) = 1，精确解y = exp(sin(x))
+func fun82(x, y float64) float64 {
+	return y * math.Cos(x)
+}
+
+func fun82_sys(x0 goNum.Matrix, i int) float64 {
+	return x0.Data[1] * math.Cos(x0.Data[0])
+}
+
+//u = exp(-pi^2*t)*sin(pi*x)，u_t = u_xx
+func fun82_heat(x, t float64) float64 {
+	return math.Exp(-math.Pi*math.Pi*t) * math.Sin(math.Pi*x)
+}
+
+func fun82_heatp(x float64) float64 {
+	return math.Sin(math.Pi * x)
+}
+
+func fun82_zero(t float64) float64 {
+	return 0.0
+}
+
+//u = sin(pi*x)*(cos(pi*t) + sin(pi*t))，u_tt = u_xx，
+//phi = sin(pi*x)，psi = pi*sin(pi*x)
+func fun82_wave(x, t float64) float64 {
+	return math.Sin(math.Pi*x) * (math.Cos(math.Pi*t) + math.Sin(math.Pi*t))
+}
+
+func fun82_wavephi(x float64) float64 {
+	return math.Sin(math.Pi * x)
+}
+
+func fun82_wavepsi(x float64) float64 {
+	return math.Pi * math.Sin(math.Pi*x)
+}
+
+func fun82_c0(x, y float64) float64 {
+	return 0.0
+}
+
+//收敛阶判断，取最后一级观测阶
+func check82(t *testing.T, name string, funerr func(int) float64, ns []int, p, tol float64) {
+	sol, err := goNum.ConvergenceOrder(funerr, ns)
+	if !err {
+		t.Errorf("%s: invalid errors %v", name, sol.RowOfMatrix(1))
+		return
+	}
+	pk := sol.GetFromMatrix(2, len(ns)-1)
+	if math.Abs(pk-p) > tol {
+		t.Errorf("%s: observed order %.3f, expected %.1f, errors %v", name, pk, p, sol.RowOfMatrix(1))
+	}
+}
+
+func TestConvergenceOrderODE(t *testing.T) {
+	ns := []int{20, 40, 80, 160}
+	xend := 2.0
+	exact := math.Exp(math.Sin(xend))
+	//单个方程
+	scalar := map[string]func(func(float64, float64) float64, float64, float64, float64, int) (goNum.Matrix, bool){
+		"ODEEuler": goNum.ODEEuler,
+		"ODEHeun":  goNum.ODEHeun,
+	}
+	orders := map[string]float64{"ODEEuler": 1.0, "ODEHeun": 2.0}
+	for name, solver := range scalar {
+		funerr := func(n int) float64 {
+			sol, _ := solver(fun82, 0.0, 1.0, xend/float64(n), n)
+			return math.Abs(sol.GetFromMatrix(n, 1) - exact)
+		}
+		check82(t, name, funerr, ns, orders[name], 0.2)
+	}
+	//方程组形式
+	system := map[string]func(func(goNum.Matrix, int) float64, goNum.Matrix, float64, int, int) (goNum.Matrix, bool){
+		"RK22":                        goNum.RK22,
+		"RK44":                        goNum.RK44,
+		"ODEAdamsEX":                  goNum.ODEAdamsEX,
+		"ODEAdamsBashforthMoultonSys": goNum.ODEAdamsBashforthMoultonSys,
+		"ODEHammingSys":               goNum.ODEHammingSys,
+		"ODEMilneSimpsonSys":          goNum.ODEMilneSimpsonSys,
+	}
+	orders = map[string]float64{"RK22": 2.0, "RK44": 4.0, "ODEAdamsEX": 4.0,
+		"ODEAdamsBashforthMoultonSys": 4.0, "ODEHammingSys": 4.0, "ODEMilneSimpsonSys": 4.0}
+	for name, solver := range system {
+		funerr := func(n int) float64 {
+			x0 := goNum.NewMatrix(2, 1, []float64{0.0, 1.0})
+			sol, _ := solver(fun82_sys, x0, xend, 1, n)
+			return math.Abs(sol.GetFromMatrix(1, n) - exact)
+		}
+		check82(t, name, funerr, ns, orders[name], 0.3)
+	}
+}
+
+func TestConvergenceOrderPDE(t *testing.T) {
+	ns := []int{8, 16, 32}
+	//抛物型，x0按列x、t
+	xh := goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 0.1})
+	heatErr := func(sol goNum.Matrix) float64 {
+		G := goNum.PDEGridUniform(xh, sol)
+		return G.ErrorNormT(fun82_heat, 0.1, 0)
+	}
+	//显式格式取l = 0.4，隐式格式取ht ~ hx^2，六点对称格式取ht ~ hx
+	check82(t, "PDEDiffParabolicE", func(m int) float64 {
+		sol, _ := goNum.PDEDiffParabolicE(fun82_heatp, fun82_zero, fun82_zero, xh, 1.0, 0.0, m, m*m/4)
+		return heatErr(sol)
+	}, ns, 2.0, 0.2)
+	check82(t, "PDEDiffParabolicI", func(m int) float64 {
+		sol, _ := goNum.PDEDiffParabolicI(fun82_heatp, fun82_zero, fun82_zero, xh, 1.0, 0.0, m, m*m/4)
+		return heatErr(sol)
+	}, ns, 2.0, 0.2)
+	check82(t, "PDEDiffParabolicS", func(m int) float64 {
+		sol, _ := goNum.PDEDiffParabolicS(fun82_heatp, fun82_zero, fun82_zero, xh, 1.0, 0.0, m, m)
+		return heatErr(sol)
+	}, ns, 2.0, 0.2)
+	check82(t, "PDEDiffParabolicMOL", func(m int) float64 {
+		funa := func(x, t, u float64) float64 { return 1.0 }
+		funf := func(x, t, u float64) float64 { return 0.0 }
+		bc := func(t float64) (float64, float64, float64) { return 1.0, 0.0, 0.0 }
+		sol, _ := goNum.PDEDiffParabolicMOL(funa, funf, fun82_heatp, bc, bc, xh, m, m*m/2, goNum.RK44)
+		return heatErr(sol)
+	}, ns, 2.0, 0.2)
+
+	//双曲型，l = 1/4
+	xw := goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 1.0})
+	waveErr := func(sol goNum.Matrix) float64 {
+		G := goNum.PDEGridUniform(xw, sol)
+		return G.ErrorNorm(fun82_wave, 0)
+	}
+	check82(t, "PDEDiffHyperbolic1", func(m int) float64 {
+		sol, _ := goNum.PDEDiffHyperbolic1(fun82_wavephi, fun82_wavepsi, fun82_zero, fun82_zero, xw, 1.0, 0.0, m, 2*m)
+		return waveErr(sol)
+	}, ns, 2.0, 0.1)
+	check82(t, "PDEDiffHyperbolic2", func(m int) float64 {
+		sol, _ := goNum.PDEDiffHyperbolic2(fun82_wavephi, fun82_wavepsi, fun82_zero, fun82_zero, xw, 1.0, 0.0, m, 2*m)
+		return waveErr(sol)
+	}, ns, 2.0, 0.1)
+	check82(t, "PDEDiffHyperbolicImplicit", func(m int) float64 {
+		sol, _ := goNum.PDEDiffHyperbolicImplicit(fun82_wavephi, fun82_wavepsi, fun82_zero, fun82_zero, xw, 1.0, 0.0, 0.25, m, m)
+		return waveErr(sol)
+	}, ns, 2.0, 0.2)
+
+	//椭圆型，u = sin(pi*x)*sin(pi*y) + x^2
+	ue := func(x, y float64) float64 { return math.Sin(math.Pi*x)*math.Sin(math.Pi*y) + x*x }
+	xe := goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 1.0})
+	check82(t, "PDEDiffEllipticalP5", func(m int) float64 {
+		fung := func(x, y float64) float64 { return -2.0*math.Pi*math.Pi*math.Sin(math.Pi*x)*math.Sin(math.Pi*y) + 2.0 }
+		fy := func(x float64) float64 { return x * x }
+		fx0 := func(y float64) float64 { return 0.0 }
+		fxa := func(y float64) float64 { return 1.0 }
+		sol, _ := goNum.PDEDiffEllipticalP5(fy, fy, fx0, fxa, fung, xe, m, m)
+		//解矩阵行为y、列为x
+		G := goNum.PDEGridUniform(xe, sol.Transpose())
+		return G.ErrorNorm(ue, 0)
+	}, ns, 2.0, 0.2)
+	for _, nine := range []bool{false, true} {
+		nine := nine
+		p := 2.0
+		if nine {
+			p = 4.0
+		}
+		check82(t, "PDEDiffEllipticalGeneral", func(m int) float64 {
+			funk := func(x, y float64) float64 { return 1.0 }
+			funf := func(x, y float64) float64 { return 2.0*math.Pi*math.Pi*math.Sin(math.Pi*x)*math.Sin(math.Pi*y) - 2.0 }
+			bc := func(x, y float64, edge int) (float64, float64, float64) { return 1.0, 0.0, ue(x, y) }
+			xs := goNum.ZeroMatrix(m+1, 1)
+			for i := 0; i < m+1; i++ {
+				xs.Data[i] = float64(i) / float64(m)
+			}
+			sol, _ := goNum.PDEDiffEllipticalGeneral(funk, fun82_c0, funf, bc, xs, xs, nine)
+			G := goNum.NewPDEGrid(xs.Data, xs.Data, sol.Transpose())
+			return G.ErrorNorm(ue, 0)
+		}, ns, p, 0.3)
+	}
+
+	//二维热传导方程，u = exp(-2pi^2*t)*sin(pi*x)*sin(pi*y)
+	x2 := goNum.NewMatrix(2, 3, []float64{0.0, 0.0, 0.0, 1.0, 1.0, 0.1})
+	heat2 := func(x, y, t float64) float64 {
+		return math.Exp(-2.0*math.Pi*math.Pi*t) * math.Sin(math.Pi*x) * math.Sin(math.Pi*y)
+	}
+	funp := func(x, y float64) float64 { return heat2(x, y, 0.0) }
+	funf := func(x, y, t float64) float64 { return 0.0 }
+	funb := func(x, y, t float64, face int) float64 { return 0.0 }
+	for name, solver := range map[string]func(func(float64, float64) float64, func(float64, float64, float64) float64,
+		func(float64, float64, float64, int) float64, goNum.Matrix, float64, int, int, int) (goNum.Matrix, bool){
+		"PDEDiffHeat2DPR":      goNum.PDEDiffHeat2DPR,
+		"PDEDiffHeat2DDouglas": goNum.PDEDiffHeat2DDouglas,
+	} {
+		solver := solver
+		check82(t, name, func(m int) float64 {
+			sol, _ := solver(funp, funf, funb, x2, 1.0, m, m, m)
+			G := goNum.PDEGridUniform(goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 1.0}), sol)
+			return G.ErrorNorm(func(x, y float64) float64 { return heat2(x, y, 0.1) }, 0)
+		}, ns, 2.0, 0.2)
+	}
+
+	//有限体积法，线性对流方程u_t + u_x = 0，周期边界
+	xa := goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 0.5})
+	funfa := func(U goNum.Matrix) goNum.Matrix { return U }
+	funpa := func(x float64, i int) float64 { return math.Sin(2.0 * math.Pi * x) }
+	for _, c := range []struct {
+		name string
+		flux func(goNum.Matrix, goNum.Matrix, float64) goNum.Matrix
+		p    float64
+	}{
+		{"PDEFVFluxUpwind", goNum.PDEFVFluxUpwind(funfa), 1.0},
+		{"PDEFVFluxLaxWendroff", goNum.PDEFVFluxLaxWendroff(funfa), 2.0},
+	} {
+		c := c
+		check82(t, c.name, func(m int) float64 {
+			sol, _ := goNum.PDEFVSolve(c.flux, funpa, xa, 1, 4*m, 4*m, 0, true)
+			e := 0.0
+			h := 1.0 / float64(4*m)
+			for i := 0; i < 4*m; i++ {
+				//单元平均值精确解
+				x := float64(i) * h
+				ua := (math.Cos(2.0*math.Pi*(x-0.5)) - math.Cos(2.0*math.Pi*(x+h-0.5))) / (2.0 * math.Pi * h)
+				e = math.Max(e, math.Abs(sol.GetFromMatrix(i, 4*m)-ua))
+			}
+			return e
+		}, ns, c.p, 0.2)
+	}
+}
+
+func BenchmarkConvergenceOrder(b *testing.B) {
+	x0 := goNum.NewMatrix(2, 1, []float64{0.0, 1.0})
+	funerr := func(n int) float64 {
+		sol, _ := goNum.RK44(fun82_sys, x0, 2.0, 1, n)
+		return math.Abs(sol.GetFromMatrix(1, n) - math.Exp(math.Sin(2.0)))
+	}
+	for i := 0; i < b.N; i++ {
+		goNum.ConvergenceOrder(funerr, []int{20, 40, 80, 160})
+	}
+}
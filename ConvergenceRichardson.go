@@ -0,0 +1,101 @@
+// ConvergenceRichardson
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    离散格式的收敛阶验证与Richardson外推（无精确解）
+理论：
+    在网格加密序列n0 < n1 < ... < nk上运行求解器，得到某一输出
+    量Qj = Q(nj)（如某点处的解、积分量等），Qj = Q* + C*nj^(-p)
+    + ...。由相邻三级可得观测收敛阶（等比加密时精确成立，否则
+    按最后一级加密比近似）：
+          log(|Q_(j-1) - Q_(j-2)|/|Qj - Q_(j-1)|)
+    pj = -----------------------------------------, j >= 2
+                  log(nj/n_(j-1))
+
+    Richardson外推值及误差估计，rj = nj/n_(j-1)：
+                 Qj - Q_(j-1)
+    Q*j = Qj + ---------------，Ej = |Q*j - Qj|
+                  rj^p - 1
+    p0 > 0时取p = p0（已知理论阶），否则取观测阶pj（j >= 2）。
+------------------------------------------------------
+输入   :
+    funq    输出量函数，网格数量n -> Q(n)
+    ns      加密序列，递增，至少三级
+    p0      理论收敛阶，p0 <= 0时使用观测阶
+输出   :
+    sol     结果矩阵，5xk，各行依次为n、Q、观测收敛阶、外推值
+            与误差估计，无法计算的位置为0
+    err     解出标志：false-相邻两级Q相等或非有限值；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+// ConvergenceRichardson 离散格式的收敛阶验证与Richardson外推（无精确解）
+func ConvergenceRichardson(funq func(int) float64, ns []int, p0 float64) (Matrix, bool) {
+	/*
+		离散格式的收敛阶验证与Richardson外推（无精确解）
+		输入   :
+		    funq    输出量函数，网格数量n -> Q(n)
+		    ns      加密序列，递增，至少三级
+		    p0      理论收敛阶，p0 <= 0时使用观测阶
+		输出   :
+		    sol     结果矩阵，5xk，各行依次为n、Q、观测收敛阶、外推值
+		            与误差估计，无法计算的位置为0
+		    err     解出标志：false-相邻两级Q相等或非有限值；
+		                     true-全部解出
+	*/
+	k := len(ns)
+	//判断加密序列
+	if k < 3 {
+		panic("Error in goNum.ConvergenceRichardson: At least three levels are needed")
+	}
+	for j := 1; j < k; j++ {
+		if ns[j] <= ns[j-1] {
+			panic("Error in goNum.ConvergenceRichardson: ns is not increasing")
+		}
+	}
+
+	var err bool = true
+	sol := ZeroMatrix(5, k)
+	for j := 0; j < k; j++ {
+		q := funq(ns[j])
+		sol.SetMatrix(0, j, float64(ns[j]))
+		sol.SetMatrix(1, j, q)
+		if math.IsNaN(q) || math.IsInf(q, 0) {
+			err = false
+		}
+	}
+	for j := 1; j < k; j++ {
+		d1 := sol.GetFromMatrix(1, j) - sol.GetFromMatrix(1, j-1)
+		r := float64(ns[j]) / float64(ns[j-1])
+		if d1 == 0.0 {
+			err = false
+			continue
+		}
+		p := p0
+		if j > 1 {
+			d0 := sol.GetFromMatrix(1, j-1) - sol.GetFromMatrix(1, j-2)
+			pj := math.Log(math.Abs(d0/d1)) / math.Log(r)
+			sol.SetMatrix(2, j, pj)
+			if p0 <= 0.0 {
+				p = pj
+			}
+		}
+		if p <= 0.0 {
+			continue
+		}
+		qe := sol.GetFromMatrix(1, j) + d1/(math.Pow(r, p)-1.0)
+		sol.SetMatrix(3, j, qe)
+		sol.SetMatrix(4, j, math.Abs(qe-sol.GetFromMatrix(1, j)))
+	}
+	return sol, err
+}
@@ -0,0 +1,154 @@
+// ConvergenceRichardson_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    离散格式的收敛阶验证与Richardson外推（无精确解）
+理论：
+    在网格加密序列n0 < n1 < ... < nk上运行求解器，得到某一输出
+    量Qj = Q(nj)（如某点处的解、积分量等），Qj = Q* + C*nj^(-p)
+    + ...。由相邻三级可得观测收敛阶（等比加密时精确成立，否则
+    按最后一级加密比近似）：
+          log(|Q_(j-1) - Q_(j-2)|/|Qj - Q_(j-1)|)
+    pj = -----------------------------------------, j >= 2
+                  log(nj/n_(j-1))
+
+    Richardson外推值及误差估计，rj = nj/n_(j-1)：
+                 Qj - Q_(j-1)
+    Q*j = Qj + ---------------，Ej = |Q*j - Qj|
+                  rj^p - 1
+    p0 > 0时取p = p0（已知理论阶），否则取观测阶pj（j >= 2）。
+------------------------------------------------------
+输入   :
+    funq    输出量函数，网格数量n -> Q(n)
+    ns      加密序列，递增，至少三级
+    p0      理论收敛阶，p0 <= 0时使用观测阶
+输出   :
+    sol     结果矩阵，5xk，各行依次为n、Q、观测收敛阶、外推值
+            与误差估计，无法计算的位置为0
+    err     解出标志：false-相邻两级Q相等或非有限值；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// ConvergenceRichardson 离散格式的收敛阶验证与Richardson外推（无精确解）
+func ConvergenceRichardson(funq func(int) float64, ns []int, p0 float64) (goNum.Matrix, bool) {
+	/*
+		离散格式的收敛阶验证与Richardson外推（无精确解）
+		输入   :
+		    funq    输出量函数，网格数量n -> Q(n)
+		    ns      加密序列，递增，至少三级
+		    p0      理论收敛阶，p0 <= 0时使用观测阶
+		输出   :
+		    sol     结果矩阵，5xk，各行依次为n、Q、观测收敛阶、外推值
+		            与误差估计，无法计算的位置为0
+		    err     解出标志：false-相邻两级Q相等或非有限值；
+		                     true-全部解出
+	*/
+	k := len(ns)
+	//判断加密序列
+	if k < 3 {
+		panic("Error in goNum.ConvergenceRichardson: At least three levels are needed")
+	}
+	for j := 1; j < k; j++ {
+		if ns[j] <= ns[j-1] {
+			panic("Error in goNum.ConvergenceRichardson: ns is not increasing")
+		}
+	}
+
+	var err bool = true
+	sol := goNum.ZeroMatrix(5, k)
+	for j := 0; j < k; j++ {
+		q := funq(ns[j])
+		sol.SetMatrix(0, j, float64(ns[j]))
+		sol.SetMatrix(1, j, q)
+		if math.IsNaN(q) || math.IsInf(q, 0) {
+			err = false
+		}
+	}
+	for j := 1; j < k; j++ {
+		d1 := sol.GetFromMatrix(1, j) - sol.GetFromMatrix(1, j-1)
+		r := float64(ns[j]) / float64(ns[j-1])
+		if d1 == 0.0 {
+			err = false
+			continue
+		}
+		p := p0
+		if j > 1 {
+			d0 := sol.GetFromMatrix(1, j-1) - sol.GetFromMatrix(1, j-2)
+			pj := math.Log(math.Abs(d0/d1)) / math.Log(r)
+			sol.SetMatrix(2, j, pj)
+			if p0 <= 0.0 {
+				p = pj
+			}
+		}
+		if p <= 0.0 {
+			continue
+		}
+		qe := sol.GetFromMatrix(1, j) + d1/(math.Pow(r, p)-1.0)
+		sol.SetMatrix(3, j, qe)
+		sol.SetMatrix(4, j, math.Abs(qe-sol.GetFromMatrix(1, j)))
+	}
+	return sol, err
+}
+
+func TestConvergenceRichardson(t *testing.T) {
+	//RK22求y' = y*cos(x)在x = 2处的值，无需精确解
+	x0 := goNum.NewMatrix(2, 1, []float64{0.0, 1.0})
+	funq := func(n int) float64 {
+		sol, _ := goNum.RK22(fun82_sys, x0, 2.0, 1, n)
+		return sol.GetFromMatrix(1, n)
+	}
+	exact := math.Exp(math.Sin(2.0))
+	sol, err := goNum.ConvergenceRichardson(funq, []int{20, 40, 80, 160}, 0.0)
+	if !err {
+		t.Fatalf("ConvergenceRichardson: solve error")
+	}
+	if p := sol.GetFromMatrix(2, 3); math.Abs(p-2.0) > 0.2 {
+		t.Errorf("RK22: observed order %.3f, expected 2", p)
+	}
+	//外推值应比最细网格的解更精确，误差估计应与实际误差同量级
+	e := math.Abs(sol.GetFromMatrix(1, 3) - exact)
+	ee := math.Abs(sol.GetFromMatrix(3, 3) - exact)
+	if ee > e/10.0 {
+		t.Errorf("RK22: extrapolated error %e not smaller than %e", ee, e)
+	}
+	if est := sol.GetFromMatrix(4, 3); (est < e/3.0) || (est > 3.0*e) {
+		t.Errorf("RK22: error estimate %e, actual error %e", est, e)
+	}
+
+	//六点对称格式求u(0.5, 0.1)
+	xh := goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 0.1})
+	funq = func(m int) float64 {
+		u, _ := goNum.PDEDiffParabolicS(fun82_heatp, fun82_zero, fun82_zero, xh, 1.0, 0.0, m, m)
+		return u.GetFromMatrix(m/2, m)
+	}
+	sol, _ = goNum.ConvergenceRichardson(funq, []int{8, 16, 32}, 2.0)
+	e = math.Abs(sol.GetFromMatrix(1, 2) - fun82_heat(0.5, 0.1))
+	ee = math.Abs(sol.GetFromMatrix(3, 2) - fun82_heat(0.5, 0.1))
+	if ee > e/10.0 {
+		t.Errorf("PDEDiffParabolicS: extrapolated error %e not smaller than %e", ee, e)
+	}
+}
+
+func BenchmarkConvergenceRichardson(b *testing.B) {
+	x0 := goNum.NewMatrix(2, 1, []float64{0.0, 1.0})
+	funq := func(n int) float64 {
+		sol, _ := goNum.RK22(fun82_sys, x0, 2.0, 1, n)
+		return sol.GetFromMatrix(1, n)
+	}
+	for i := 0; i < b.N; i++ {
+		goNum.ConvergenceRichardson(funq, []int{20, 40, 80, 160}, 0.0)
+	}
+}
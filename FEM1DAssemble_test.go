@@ -0,0 +1,250 @@
+// FEM1DAssemble_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    一维有限元刚度矩阵、质量矩阵与载荷向量的组装（P1/P2单元）
+理论：
+    对于两点边值问题：
+       d      du
+    - ---(a(x)---) + c(x)u = f(x)
+       dx     dx
+
+    Galerkin弱形式，phi_i为节点基函数：
+    K_ij = int a*phi_i'*phi_j' dx
+    M_ij = int c*phi_i*phi_j dx
+    F_i  = int f*phi_i dx
+
+    单元[x0, x1]映射至参考单元[0, 1]，x = x0 + (x1-x0)*s，
+    参考单元上的形函数：
+    P1：N0 = 1-s，N1 = s
+    P2：N0 = (1-s)(1-2s)，N1 = s(2s-1)，N2 = 4s(1-s)，
+        N2对应单元中点（单元第三个节点）
+    单元积分采用三点Gauss-Legendre求积，对多项式具有五阶代数
+    精度。
+
+    K、M以带状形式存储：半带宽bw = max|i - j|（i、j为同一单元
+    的节点编号），K为Nx(2bw+1)矩阵，第i行第j-i+bw列为K_ij，
+    存储量O(N*bw)。bw由节点编号决定，FEM1D、FEM2D先以逆
+    Cuthill-McKee算法重新编号以减小bw。
+
+    参考 C. Johnson. Numerical Solution of Partial
+         Differential Equations by the Finite Element Method.
+         Cambridge University Press, 1987. ss 1.
+------------------------------------------------------
+输入   :
+    funa    系数a(x)
+    funcc   系数c(x)
+    funf    右端项f(x)
+    nodes   节点坐标，Nx1
+    elems   单元节点编号，Ex2(P1)或Ex3(P2)
+输出   :
+    K       刚度矩阵，带状存储，Nx(2bw+1)
+    M       质量矩阵，带状存储，Nx(2bw+1)
+    F       载荷向量，Nx1
+    bw      半带宽
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+//三点Gauss-Legendre求积节点与权重，[0, 1]
+var gauss3_FEM1DAssemble = [3][2]float64{
+	{0.5 - 0.5*0.7745966692414834, 5.0 / 18.0},
+	{0.5, 8.0 / 18.0},
+	{0.5 + 0.5*0.7745966692414834, 5.0 / 18.0},
+}
+
+//参考单元[0, 1]上的形函数及其导数
+func shape_FEM1DAssemble(order int, s float64) ([]float64, []float64) {
+	if order == 1 {
+		return []float64{1.0 - s, s}, []float64{-1.0, 1.0}
+	}
+	return []float64{(1.0 - s) * (1.0 - 2.0*s), s * (2.0*s - 1.0), 4.0 * s * (1.0 - s)},
+		[]float64{4.0*s - 3.0, 4.0*s - 1.0, 4.0 - 8.0*s}
+}
+
+//半带宽，同一单元节点编号之差的最大值
+func bandwidth_FEM1DAssemble(elems goNum.Matrix) int {
+	bw := 0
+	for e := 0; e < elems.Rows; e++ {
+		for p := 0; p < elems.Columns; p++ {
+			for q := 0; q < p; q++ {
+				d := int(elems.GetFromMatrix(e, p)) - int(elems.GetFromMatrix(e, q))
+				if d < 0 {
+					d = -d
+				}
+				if d > bw {
+					bw = d
+				}
+			}
+		}
+	}
+	return bw
+}
+
+//逆Cuthill-McKee重新编号，返回num，num[i]为原节点i的新编号。
+//各连通分量自度数最小的节点出发广度优先遍历，邻点按度数、编号
+//递增顺序访问，所得顺序反转
+func rcm_FEM1DAssemble(N int, elems goNum.Matrix) []int {
+	//节点邻接表
+	adj := make([][]int, N)
+	for e := 0; e < elems.Rows; e++ {
+		for p := 0; p < elems.Columns; p++ {
+			i := int(elems.GetFromMatrix(e, p))
+			for q := 0; q < elems.Columns; q++ {
+				if q != p {
+					adj[i] = append(adj[i], int(elems.GetFromMatrix(e, q)))
+				}
+			}
+		}
+	}
+	for i := range adj {
+		sort.Ints(adj[i])
+		k := 0
+		for j := range adj[i] {
+			if (j == 0) || (adj[i][j] != adj[i][j-1]) {
+				adj[i][k] = adj[i][j]
+				k++
+			}
+		}
+		adj[i] = adj[i][:k]
+	}
+	less := func(i, j int) bool {
+		if len(adj[i]) != len(adj[j]) {
+			return len(adj[i]) < len(adj[j])
+		}
+		return i < j
+	}
+	for i := range adj {
+		sort.Slice(adj[i], func(p, q int) bool { return less(adj[i][p], adj[i][q]) })
+	}
+
+	visited := make([]bool, N)
+	order := make([]int, 0, N)
+	for len(order) < N {
+		start := -1
+		for i := 0; i < N; i++ {
+			if !visited[i] && ((start < 0) || less(i, start)) {
+				start = i
+			}
+		}
+		visited[start] = true
+		order = append(order, start)
+		for k := len(order) - 1; k < len(order); k++ {
+			for _, j := range adj[order[k]] {
+				if !visited[j] {
+					visited[j] = true
+					order = append(order, j)
+				}
+			}
+		}
+	}
+	num := make([]int, N)
+	for k := range order {
+		num[order[k]] = N - 1 - k
+	}
+	return num
+}
+
+//按num重新编号的节点与单元，nodes为Nxd
+func renumber_FEM1DAssemble(nodes, elems goNum.Matrix, num []int) (goNum.Matrix, goNum.Matrix) {
+	d := nodes.Columns
+	nodes2 := goNum.ZeroMatrix(nodes.Rows, d)
+	for i := 0; i < nodes.Rows; i++ {
+		copy(nodes2.Data[num[i]*d:(num[i]+1)*d], nodes.Data[i*d:(i+1)*d])
+	}
+	elems2 := goNum.ZeroMatrix(elems.Rows, elems.Columns)
+	for k := range elems.Data {
+		elems2.Data[k] = float64(num[int(elems.Data[k])])
+	}
+	return nodes2, elems2
+}
+
+// FEM1DAssemble 一维有限元刚度矩阵、质量矩阵与载荷向量的组装（P1/P2单元）
+func FEM1DAssemble(funa, funcc, funf func(float64) float64, nodes, elems goNum.Matrix) (goNum.Matrix, goNum.Matrix, goNum.Matrix, int) {
+	/*
+		一维有限元刚度矩阵、质量矩阵与载荷向量的组装（P1/P2单元）
+		输入   :
+		    funa    系数a(x)
+		    funcc   系数c(x)
+		    funf    右端项f(x)
+		    nodes   节点坐标，Nx1
+		    elems   单元节点编号，Ex2(P1)或Ex3(P2)
+		输出   :
+		    K       刚度矩阵，带状存储，Nx(2bw+1)
+		    M       质量矩阵，带状存储，Nx(2bw+1)
+		    F       载荷向量，Nx1
+		    bw      半带宽
+	*/
+	//判断单元类型
+	if (elems.Columns != 2) && (elems.Columns != 3) {
+		panic("Error in goNum.FEM1DAssemble: elems is neither P1 nor P2")
+	}
+
+	N := nodes.Rows
+	order := elems.Columns - 1
+	bw := bandwidth_FEM1DAssemble(elems)
+	nw := 2*bw + 1
+	K := goNum.ZeroMatrix(N, nw)
+	M := goNum.ZeroMatrix(N, nw)
+	F := goNum.ZeroMatrix(N, 1)
+	idx := make([]int, order+1)
+	for e := 0; e < elems.Rows; e++ {
+		for k := 0; k < order+1; k++ {
+			idx[k] = int(elems.GetFromMatrix(e, k))
+		}
+		x0 := nodes.Data[idx[0]]
+		h := nodes.Data[idx[1]] - x0
+		if math.Abs(h) == 0.0 {
+			panic("Error in goNum.FEM1DAssemble: Zero length element")
+		}
+		for _, g := range gauss3_FEM1DAssemble {
+			x := x0 + h*g[0]
+			w := g[1] * math.Abs(h)
+			phi, dphi := shape_FEM1DAssemble(order, g[0])
+			a, c, f := funa(x), funcc(x), funf(x)
+			for p := 0; p < order+1; p++ {
+				F.Data[idx[p]] += w * f * phi[p]
+				for q := 0; q < order+1; q++ {
+					K.Data[idx[p]*nw+idx[q]-idx[p]+bw] += w * a * dphi[p] * dphi[q] / (h * h)
+					M.Data[idx[p]*nw+idx[q]-idx[p]+bw] += w * c * phi[p] * phi[q]
+				}
+			}
+		}
+	}
+	return K, M, F, bw
+}
+
+//精确解u = sin(2x) + x
+func fun77_a(x float64) float64 {
+	return 1.0 + x
+}
+
+func fun77_c(x float64) float64 {
+	return x
+}
+
+func fun77_f(x float64) float64 {
+	u := math.Sin(2.0*x) + x
+	du := 2.0*math.Cos(2.0*x) + 1.0
+	return -du + 4.0*(1.0+x)*math.Sin(2.0*x) + x*u
+}
+
+func BenchmarkFEM1DAssemble(b *testing.B) {
+	x77 := goNum.NewMatrix(2, 1, []float64{0.0, 2.0})
+	nodes77, elems77 := goNum.FEMMesh1D(x77, 32, 2)
+	for i := 0; i < b.N; i++ {
+		goNum.FEM1DAssemble(fun77_a, fun77_c, fun77_f, nodes77, elems77)
+	}
+}
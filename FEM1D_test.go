@@ -0,0 +1,142 @@
+// FEM1D_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    求解两点边值问题的有限元法（P1/P2单元）
+理论：
+    对于两点边值问题：
+       d      du
+    - ---(a(x)---) + c(x)u = f(x), xl < x < xr
+       dx     dx
+
+    两端：p*u + q*a*du/dn = g，n为外法向，端号end：0-左端，
+    1-右端。q = 0为Dirichlet边界，p = 0为Neumann边界，否则为
+    Robin边界。
+
+    由FEM1DAssemble组装K、M、F，Galerkin方程组：
+    (K + M + R)U = F + G
+    Robin/Neumann端：R_ii = p/q，G_i = g/q；Dirichlet端以
+    u_i = g/p替换第i个方程。节点先以逆Cuthill-McKee算法重新编号，
+    方程组以带状形式存储，由带状Gauss消去法求解，计算量
+    O(N*bw^2)。
+    光滑解时P1单元L2误差为二阶，P2单元为三阶。
+
+    参考 C. Johnson. Numerical Solution of Partial
+         Differential Equations by the Finite Element Method.
+         Cambridge University Press, 1987. ss 1.
+------------------------------------------------------
+输入   :
+    funa    系数a(x)
+    funcc   系数c(x)
+    funf    右端项f(x)
+    bc      边界函数(x, end)，返回(p, q, g)
+    nodes   节点坐标，Nx1
+    elems   单元节点编号，Ex2(P1)或Ex3(P2)
+输出   :
+    sol     各节点上的解，Nx1
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// FEM1D 求解两点边值问题的有限元法（P1/P2单元）
+func FEM1D(funa, funcc, funf func(float64) float64, bc func(float64, int) (float64, float64, float64),
+	nodes, elems goNum.Matrix) (goNum.Matrix, bool) {
+	/*
+		求解两点边值问题的有限元法（P1/P2单元）
+		输入   :
+		    funa    系数a(x)
+		    funcc   系数c(x)
+		    funf    右端项f(x)
+		    bc      边界函数(x, end)，返回(p, q, g)
+		    nodes   节点坐标，Nx1
+		    elems   单元节点编号，Ex2(P1)或Ex3(P2)
+		输出   :
+		    sol     各节点上的解，Nx1
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断网格
+	if (nodes.Rows < 2) || (elems.Rows < 1) {
+		panic("Error in goNum.FEM1D: Mesh error")
+	}
+
+	var err bool = false
+	N := nodes.Rows
+	//重新编号以减小半带宽
+	num := rcm_FEM1DAssemble(N, elems)
+	nodes2, elems2 := renumber_FEM1DAssemble(nodes, elems, num)
+	K, M, F, bw := goNum.FEM1DAssemble(funa, funcc, funf, nodes2, elems2)
+	A := goNum.AddMatrix(K, M)
+	nw := 2*bw + 1
+
+	//两端节点
+	il, ir := 0, 0
+	for i := 1; i < N; i++ {
+		if nodes.Data[i] < nodes.Data[il] {
+			il = i
+		}
+		if nodes.Data[i] > nodes.Data[ir] {
+			ir = i
+		}
+	}
+	for end, i := range []int{il, ir} {
+		p, q, g := bc(nodes.Data[i], end)
+		i = num[i]
+		if q == 0.0 {
+			if p == 0.0 {
+				panic("Error in goNum.FEM1D: Boundary condition error")
+			}
+			for j := 0; j < nw; j++ {
+				A.SetMatrix(i, j, 0.0)
+			}
+			A.SetMatrix(i, bw, 1.0)
+			F.Data[i] = g / p
+		} else {
+			A.SetMatrix(i, bw, A.GetFromMatrix(i, bw)+p/q)
+			F.Data[i] += g / q
+		}
+	}
+
+	temp0, temperr := bandSolve_PDEDiffEllipticalGeneral(goNum.Matrix2ToSlices(A), F.Data, bw)
+	if temperr != true {
+		return goNum.ZeroMatrix(N, 1), err
+	}
+	sol := goNum.ZeroMatrix(N, 1)
+	for i := 0; i < N; i++ {
+		sol.Data[i] = temp0[num[i]]
+	}
+
+	err = true
+	return sol, err
+}
+
+//左端Dirichlet，右端Robin
+func fun77_bc(x float64, end int) (float64, float64, float64) {
+	u := math.Sin(2.0*x) + x
+	if end == 0 {
+		return 1.0, 0.0, u
+	}
+	du := 2.0*math.Cos(2.0*x) + 1.0
+	return 1.0, 1.0, u + fun77_a(x)*du
+}
+
+func BenchmarkFEM1D(b *testing.B) {
+	x77 := goNum.NewMatrix(2, 1, []float64{0.0, 2.0})
+	nodes77, elems77 := goNum.FEMMesh1D(x77, 32, 2)
+	for i := 0; i < b.N; i++ {
+		goNum.FEM1D(fun77_a, fun77_c, fun77_f, fun77_bc, nodes77, elems77)
+	}
+}
@@ -0,0 +1,173 @@
+// FEM2D
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    求解二维椭圆型偏微分方程的三角形有限元法（P1/P2单元）
+理论：
+    对于椭圆型偏微分方程：
+    -div(a(x, y)grad u) + c(x, y)u = f(x, y)
+
+    边界：p*u + q*a*du/dn = g，n为外法向，(p, q, g)由边界上的
+    位置(x, y)给出。q = 0为Dirichlet边界，p = 0为Neumann边界，
+    否则为Robin边界。
+
+    由FEM2DAssemble组装K、M、F，Galerkin方程组：
+    (K + M + R)U = F + G
+    只属于一个单元的边为边界边，沿边界边以三点Gauss-Legendre
+    求积：
+    R_ij = int p/q*phi_i*phi_j ds，G_i = int g/q*phi_i ds
+    边界节点上q = 0时以u_i = g/p替换第i个方程。节点先以逆
+    Cuthill-McKee算法重新编号，方程组以带状形式存储，由带状
+    Gauss消去法求解，计算量O(N*bw^2)，nx x ny矩形网格上
+    bw = O(min(nx, ny))。光滑解时P1单元L2误差为二阶，P2单元为
+    三阶。
+
+    参考 C. Johnson. Numerical Solution of Partial
+         Differential Equations by the Finite Element Method.
+         Cambridge University Press, 1987. ss 4.
+------------------------------------------------------
+输入   :
+    funa    系数a(x, y)
+    funcc   系数c(x, y)
+    funf    右端项f(x, y)
+    bc      边界函数(x, y)，返回(p, q, g)
+    nodes   节点坐标，Nx2
+    elems   单元节点编号，Ex3(P1)或Ex6(P2)
+输出   :
+    sol     各节点上的解，Nx1
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+//第e个单元第k条边(vk, v(k+1))的无序编号对
+func key_FEM2D(elems Matrix, e, k int) [2]int {
+	v0 := int(elems.GetFromMatrix(e, k))
+	v1 := int(elems.GetFromMatrix(e, (k+1)%3))
+	if v0 > v1 {
+		return [2]int{v1, v0}
+	}
+	return [2]int{v0, v1}
+}
+
+// FEM2D 求解二维椭圆型偏微分方程的三角形有限元法（P1/P2单元）
+func FEM2D(funa, funcc, funf func(float64, float64) float64, bc func(float64, float64) (float64, float64, float64),
+	nodes, elems Matrix) (Matrix, bool) {
+	/*
+		求解二维椭圆型偏微分方程的三角形有限元法（P1/P2单元）
+		输入   :
+		    funa    系数a(x, y)
+		    funcc   系数c(x, y)
+		    funf    右端项f(x, y)
+		    bc      边界函数(x, y)，返回(p, q, g)
+		    nodes   节点坐标，Nx2
+		    elems   单元节点编号，Ex3(P1)或Ex6(P2)
+		输出   :
+		    sol     各节点上的解，Nx1
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断网格
+	if (nodes.Rows < 3) || (elems.Rows < 1) {
+		panic("Error in goNum.FEM2D: Mesh error")
+	}
+
+	var err bool = false
+	N := nodes.Rows
+	//重新编号以减小半带宽，以下nodes、elems均为新编号
+	num := rcm_FEM1DAssemble(N, elems)
+	nodes, elems = renumber_FEM1DAssemble(nodes, elems, num)
+	K, M, F, bw := FEM2DAssemble(funa, funcc, funf, nodes, elems)
+	A := AddMatrix(K, M)
+	nw := 2*bw + 1
+	p2 := elems.Columns == 6
+
+	//统计各边所属单元数
+	count := make(map[[2]int]int)
+	for e := 0; e < elems.Rows; e++ {
+		for k := 0; k < 3; k++ {
+			count[key_FEM2D(elems, e, k)]++
+		}
+	}
+
+	//Robin/Neumann边界边积分，按单元与边的顺序，结果可重复
+	dirichlet := make(map[int]float64)
+	var dorder []int //Dirichlet节点，按首次出现的顺序
+	for e := 0; e < elems.Rows; e++ {
+		for k := 0; k < 3; k++ {
+			if count[key_FEM2D(elems, e, k)] != 1 {
+				continue
+			}
+			//边界边节点(v0, v1, 中点)
+			ed := [3]int{int(elems.GetFromMatrix(e, k)), int(elems.GetFromMatrix(e, (k+1)%3)), -1}
+			if p2 {
+				ed[2] = int(elems.GetFromMatrix(e, 3+k))
+			}
+			x0, y0 := nodes.Data[2*ed[0]], nodes.Data[2*ed[0]+1]
+			x1, y1 := nodes.Data[2*ed[1]], nodes.Data[2*ed[1]+1]
+			l := math.Sqrt((x1-x0)*(x1-x0) + (y1-y0)*(y1-y0))
+			idx := ed[:2]
+			order := 1
+			if p2 {
+				idx = ed[:]
+				order = 2
+			}
+			for _, g := range gauss3_FEM1DAssemble {
+				p, q, gv := bc(x0+(x1-x0)*g[0], y0+(y1-y0)*g[0])
+				if q == 0.0 {
+					continue
+				}
+				w := g[1] * l
+				phi, _ := shape_FEM1DAssemble(order, g[0])
+				for i := range idx {
+					F.Data[idx[i]] += w * gv / q * phi[i]
+					for j := range idx {
+						A.Data[idx[i]*nw+idx[j]-idx[i]+bw] += w * p / q * phi[i] * phi[j]
+					}
+				}
+			}
+			//Dirichlet边界节点
+			for _, i := range idx {
+				if _, ok := dirichlet[i]; ok {
+					continue
+				}
+				p, q, gv := bc(nodes.Data[2*i], nodes.Data[2*i+1])
+				if q == 0.0 {
+					if p == 0.0 {
+						panic("Error in goNum.FEM2D: Boundary condition error")
+					}
+					dirichlet[i] = gv / p
+					dorder = append(dorder, i)
+				}
+			}
+		}
+	}
+	for _, i := range dorder {
+		for j := 0; j < nw; j++ {
+			A.Data[i*nw+j] = 0.0
+		}
+		A.Data[i*nw+bw] = 1.0
+		F.Data[i] = dirichlet[i]
+	}
+
+	temp0, temperr := bandSolve_PDEDiffEllipticalGeneral(Matrix2ToSlices(A), F.Data, bw)
+	if temperr != true {
+		return ZeroMatrix(N, 1), err
+	}
+	sol := ZeroMatrix(N, 1)
+	for i := 0; i < N; i++ {
+		sol.Data[i] = temp0[num[i]]
+	}
+
+	err = true
+	return sol, err
+}
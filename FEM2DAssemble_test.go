@@ -0,0 +1,214 @@
+// FEM2DAssemble_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    二维三角形有限元刚度矩阵、质量矩阵与载荷向量的组装（P1/P2单元）
+理论：
+    对于椭圆型偏微分方程：
+    -div(a(x, y)grad u) + c(x, y)u = f(x, y)
+
+    Galerkin弱形式，phi_i为节点基函数：
+    K_ij = int a*grad(phi_i).grad(phi_j) dxdy
+    M_ij = int c*phi_i*phi_j dxdy
+    F_i  = int f*phi_i dxdy
+
+    三角形单元(v0, v1, v2)映射至参考单元(0, 0), (1, 0), (0, 1)，
+    重心坐标L0 = 1-s-t，L1 = s，L2 = t，参考单元上的形函数：
+    P1：Ni = Li
+    P2：Ni = Li(2Li-1)，i = 0, 1, 2；
+        N3 = 4L0L1，N4 = 4L1L2，N5 = 4L2L0，对应各边中点
+    单元积分采用六点Dunavant求积，对多项式具有四阶代数精度，
+    P2单元的质量矩阵精确积分。
+
+    K、M以带状形式存储（同FEM1DAssemble），Nx(2bw+1)，第i行
+    第j-i+bw列为K_ij，bw为同一单元节点编号之差的最大值。
+
+    参考 D.A. Dunavant. High degree efficient symmetrical
+         Gaussian quadrature rules for the triangle. Int. J.
+         Numer. Meth. Eng., 1985, 21: 1129-1148.
+------------------------------------------------------
+输入   :
+    funa    系数a(x, y)
+    funcc   系数c(x, y)
+    funf    右端项f(x, y)
+    nodes   节点坐标，Nx2
+    elems   单元节点编号，Ex3(P1)或Ex6(P2)
+输出   :
+    K       刚度矩阵，带状存储，Nx(2bw+1)
+    M       质量矩阵，带状存储，Nx(2bw+1)
+    F       载荷向量，Nx1
+    bw      半带宽
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+//六点Dunavant求积节点(s, t)与权重，权重和为1
+var dunavant6_FEM2DAssemble = [6][3]float64{
+	{0.445948490915965, 0.445948490915965, 0.223381589678011},
+	{0.108103018168070, 0.445948490915965, 0.223381589678011},
+	{0.445948490915965, 0.108103018168070, 0.223381589678011},
+	{0.091576213509771, 0.091576213509771, 0.109951743655322},
+	{0.816847572980459, 0.091576213509771, 0.109951743655322},
+	{0.091576213509771, 0.816847572980459, 0.109951743655322},
+}
+
+//参考单元上的形函数及其对s、t的导数
+func shape_FEM2DAssemble(order int, s, t float64) ([]float64, []float64, []float64) {
+	L := [3]float64{1.0 - s - t, s, t}
+	dLs := [3]float64{-1.0, 1.0, 0.0}
+	dLt := [3]float64{-1.0, 0.0, 1.0}
+	if order == 1 {
+		return L[:], dLs[:], dLt[:]
+	}
+	phi := make([]float64, 6)
+	dphis := make([]float64, 6)
+	dphit := make([]float64, 6)
+	for i := 0; i < 3; i++ {
+		phi[i] = L[i] * (2.0*L[i] - 1.0)
+		dphis[i] = (4.0*L[i] - 1.0) * dLs[i]
+		dphit[i] = (4.0*L[i] - 1.0) * dLt[i]
+		j := (i + 1) % 3
+		phi[3+i] = 4.0 * L[i] * L[j]
+		dphis[3+i] = 4.0 * (dLs[i]*L[j] + L[i]*dLs[j])
+		dphit[3+i] = 4.0 * (dLt[i]*L[j] + L[i]*dLt[j])
+	}
+	return phi, dphis, dphit
+}
+
+// FEM2DAssemble 二维三角形有限元刚度矩阵、质量矩阵与载荷向量的组装（P1/P2单元）
+func FEM2DAssemble(funa, funcc, funf func(float64, float64) float64, nodes, elems goNum.Matrix) (goNum.Matrix, goNum.Matrix, goNum.Matrix, int) {
+	/*
+		二维三角形有限元刚度矩阵、质量矩阵与载荷向量的组装（P1/P2单元）
+		输入   :
+		    funa    系数a(x, y)
+		    funcc   系数c(x, y)
+		    funf    右端项f(x, y)
+		    nodes   节点坐标，Nx2
+		    elems   单元节点编号，Ex3(P1)或Ex6(P2)
+		输出   :
+		    K       刚度矩阵，带状存储，Nx(2bw+1)
+		    M       质量矩阵，带状存储，Nx(2bw+1)
+		    F       载荷向量，Nx1
+		    bw      半带宽
+	*/
+	//判断单元类型
+	if (nodes.Columns != 2) || ((elems.Columns != 3) && (elems.Columns != 6)) {
+		panic("Error in goNum.FEM2DAssemble: elems is neither P1 nor P2")
+	}
+
+	N := nodes.Rows
+	nb := elems.Columns //单元节点数
+	order := 1
+	if nb == 6 {
+		order = 2
+	}
+	bw := bandwidth_FEM1DAssemble(elems)
+	nw := 2*bw + 1
+	K := goNum.ZeroMatrix(N, nw)
+	M := goNum.ZeroMatrix(N, nw)
+	F := goNum.ZeroMatrix(N, 1)
+	idx := make([]int, nb)
+	dx := make([]float64, nb)
+	dy := make([]float64, nb)
+	for e := 0; e < elems.Rows; e++ {
+		for k := 0; k < nb; k++ {
+			idx[k] = int(elems.GetFromMatrix(e, k))
+		}
+		x0, y0 := nodes.Data[2*idx[0]], nodes.Data[2*idx[0]+1]
+		//Jacobi矩阵J = [x1-x0, x2-x0; y1-y0, y2-y0]
+		j11, j12 := nodes.Data[2*idx[1]]-x0, nodes.Data[2*idx[2]]-x0
+		j21, j22 := nodes.Data[2*idx[1]+1]-y0, nodes.Data[2*idx[2]+1]-y0
+		det := j11*j22 - j12*j21
+		if det == 0.0 {
+			panic("Error in goNum.FEM2DAssemble: Degenerate element")
+		}
+		area := math.Abs(det) / 2.0
+		for _, g := range dunavant6_FEM2DAssemble {
+			x := x0 + j11*g[0] + j12*g[1]
+			y := y0 + j21*g[0] + j22*g[1]
+			w := g[2] * area
+			phi, dphis, dphit := shape_FEM2DAssemble(order, g[0], g[1])
+			//grad = J^(-T) (d/ds, d/dt)
+			for k := 0; k < nb; k++ {
+				dx[k] = (j22*dphis[k] - j21*dphit[k]) / det
+				dy[k] = (-j12*dphis[k] + j11*dphit[k]) / det
+			}
+			a, c, f := funa(x, y), funcc(x, y), funf(x, y)
+			for p := 0; p < nb; p++ {
+				F.Data[idx[p]] += w * f * phi[p]
+				for q := 0; q < nb; q++ {
+					K.Data[idx[p]*nw+idx[q]-idx[p]+bw] += w * a * (dx[p]*dx[q] + dy[p]*dy[q])
+					M.Data[idx[p]*nw+idx[q]-idx[p]+bw] += w * c * phi[p] * phi[q]
+				}
+			}
+		}
+	}
+	return K, M, F, bw
+}
+
+//精确解u = exp(x)*sin(y)
+func fun78_a(x, y float64) float64 {
+	return 1.0 + x*y
+}
+
+func fun78_c(x, y float64) float64 {
+	return 1.0
+}
+
+func fun78_f(x, y float64) float64 {
+	return -(y*math.Exp(x)*math.Sin(y) + x*math.Exp(x)*math.Cos(y)) + math.Exp(x)*math.Sin(y)
+}
+
+func BenchmarkFEM2DAssemble(b *testing.B) {
+	x78 := goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 1.0})
+	nodes78, elems78 := goNum.FEMMesh2D(x78, 8, 8, 2)
+	for i := 0; i < b.N; i++ {
+		goNum.FEM2DAssemble(fun78_a, fun78_c, fun78_f, nodes78, elems78)
+	}
+}
+
+func TestFEM2DAssemble(t *testing.T) {
+	//带状存储：K、M对称，K行和为0，M元素和为区域面积（c = 1）
+	x78 := goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 1.0})
+	for _, order := range []int{1, 2} {
+		nodes78, elems78 := goNum.FEMMesh2D(x78, 4, 4, order)
+		K, M, _, bw := goNum.FEM2DAssemble(fun78_a, fun78_c, fun78_f, nodes78, elems78)
+		N, nw := nodes78.Rows, 2*bw+1
+		if (K.Rows != N) || (K.Columns != nw) || (M.Columns != nw) {
+			t.Fatalf("order %d: K is %dx%d, bw = %d", order, K.Rows, K.Columns, bw)
+		}
+		var summ float64
+		for i := 0; i < N; i++ {
+			var sumk float64
+			for j := i - bw; j <= i+bw; j++ {
+				if (j < 0) || (j >= N) {
+					continue
+				}
+				kij, kji := K.Data[i*nw+j-i+bw], K.Data[j*nw+i-j+bw]
+				mij, mji := M.Data[i*nw+j-i+bw], M.Data[j*nw+i-j+bw]
+				if (math.Abs(kij-kji) > 1e-12) || (math.Abs(mij-mji) > 1e-12) {
+					t.Errorf("order %d: not symmetric at (%d, %d)", order, i, j)
+				}
+				sumk += kij
+				summ += mij
+			}
+			if math.Abs(sumk) > 1e-12 {
+				t.Errorf("order %d: row %d of K sums to %v", order, i, sumk)
+			}
+		}
+		if math.Abs(summ-1.0) > 1e-12 {
+			t.Errorf("order %d: sum of M = %v", order, summ)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// FEMMesh1D_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    生成一维等距有限元网格（线性P1或二次P2单元）
+理论：
+    区间[a, b]分为n个单元，节点xi = a + i*h，h = (b-a)/n。
+    order = 1时单元为(xi, x_(i+1))，节点编号0～n；
+    order = 2时增加单元中点为第三个节点，单元为
+    (xi, x_(i+1), x_(i+1/2))，中点编号为n+1+i。
+
+    节点与单元数组即为FEM1DAssemble、FEM1D的输入，也可按相同
+    格式自行构造非等距网格。
+------------------------------------------------------
+输入   :
+    x0      求解范围，2x1
+    n       单元数量
+    order   单元阶次，1或2
+输出   :
+    nodes   节点坐标，Nx1
+    elems   单元节点编号，nx(order+1)
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// FEMMesh1D 生成一维等距有限元网格（线性P1或二次P2单元）
+func FEMMesh1D(x0 goNum.Matrix, n, order int) (goNum.Matrix, goNum.Matrix) {
+	/*
+		生成一维等距有限元网格（线性P1或二次P2单元）
+		输入   :
+		    x0      求解范围，2x1
+		    n       单元数量
+		    order   单元阶次，1或2
+		输出   :
+		    nodes   节点坐标，Nx1
+		    elems   单元节点编号，nx(order+1)
+	*/
+	//判断单元数量
+	if n < 1 {
+		panic("Error in goNum.FEMMesh1D: Element numbers error")
+	}
+	//判断单元阶次
+	if (order != 1) && (order != 2) {
+		panic("Error in goNum.FEMMesh1D: order is not 1 or 2")
+	}
+
+	xa := x0.GetFromMatrix(0, 0)
+	h := (x0.GetFromMatrix(1, 0) - xa) / float64(n)
+	N := n + 1
+	if order == 2 {
+		N += n
+	}
+	nodes := goNum.ZeroMatrix(N, 1)
+	elems := goNum.ZeroMatrix(n, order+1)
+	for i := 0; i < n+1; i++ {
+		nodes.Data[i] = xa + float64(i)*h
+	}
+	for i := 0; i < n; i++ {
+		elems.SetMatrix(i, 0, float64(i))
+		elems.SetMatrix(i, 1, float64(i+1))
+		if order == 2 {
+			nodes.Data[n+1+i] = xa + (float64(i)+0.5)*h
+			elems.SetMatrix(i, 2, float64(n+1+i))
+		}
+	}
+	return nodes, elems
+}
+
+func BenchmarkFEMMesh1D(b *testing.B) {
+	x77 := goNum.NewMatrix(2, 1, []float64{0.0, 2.0})
+	for i := 0; i < b.N; i++ {
+		goNum.FEMMesh1D(x77, 100, 2)
+	}
+}
@@ -0,0 +1,95 @@
+// FEMMesh2D_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    生成矩形区域的二维三角形有限元网格（线性P1或二次P2单元）
+理论：
+    x、y方向分别分为nx、ny等份，顶点(xi, yj)编号为j*(nx+1)+i，
+    每个矩形网格沿左下-右上对角线分为两个三角形，顶点按逆时针
+    排列：
+    (p00, p10, p11)，(p00, p11, p01)
+    order = 2时由FEMMeshP2增加各边中点。
+
+    节点与单元数组即为FEM2DAssemble、FEM2D的输入，也可按相同
+    格式自行构造一般区域的网格。
+------------------------------------------------------
+输入   :
+    x0      求解范围，2x2，第一行为x、y的起点，第二行为终点
+    nx, ny  x、y方向网格数量
+    order   单元阶次，1或2
+输出   :
+    nodes   节点坐标，Nx2
+    elems   单元节点编号，(2*nx*ny)x3或(2*nx*ny)x6
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// FEMMesh2D 生成矩形区域的二维三角形有限元网格（线性P1或二次P2单元）
+func FEMMesh2D(x0 goNum.Matrix, nx, ny, order int) (goNum.Matrix, goNum.Matrix) {
+	/*
+		生成矩形区域的二维三角形有限元网格（线性P1或二次P2单元）
+		输入   :
+		    x0      求解范围，2x2，第一行为x、y的起点，第二行为终点
+		    nx, ny  x、y方向网格数量
+		    order   单元阶次，1或2
+		输出   :
+		    nodes   节点坐标，Nx2
+		    elems   单元节点编号，(2*nx*ny)x3或(2*nx*ny)x6
+	*/
+	//判断网格数量
+	if (nx < 1) || (ny < 1) {
+		panic("Error in goNum.FEMMesh2D: Grid numbers error")
+	}
+	//判断单元阶次
+	if (order != 1) && (order != 2) {
+		panic("Error in goNum.FEMMesh2D: order is not 1 or 2")
+	}
+
+	xa, ya := x0.GetFromMatrix(0, 0), x0.GetFromMatrix(0, 1)
+	hx := (x0.GetFromMatrix(1, 0) - xa) / float64(nx) //x方向步长
+	hy := (x0.GetFromMatrix(1, 1) - ya) / float64(ny) //y方向步长
+	nodes := goNum.ZeroMatrix((nx+1)*(ny+1), 2)
+	for j := 0; j < ny+1; j++ {
+		for i := 0; i < nx+1; i++ {
+			nodes.SetMatrix(j*(nx+1)+i, 0, xa+float64(i)*hx)
+			nodes.SetMatrix(j*(nx+1)+i, 1, ya+float64(j)*hy)
+		}
+	}
+	elems := goNum.ZeroMatrix(2*nx*ny, 3)
+	for j := 0; j < ny; j++ {
+		for i := 0; i < nx; i++ {
+			p00 := float64(j*(nx+1) + i)
+			p10 := p00 + 1.0
+			p01 := p00 + float64(nx+1)
+			p11 := p01 + 1.0
+			e := 2 * (j*nx + i)
+			elems.SetMatrix(e, 0, p00)
+			elems.SetMatrix(e, 1, p10)
+			elems.SetMatrix(e, 2, p11)
+			elems.SetMatrix(e+1, 0, p00)
+			elems.SetMatrix(e+1, 1, p11)
+			elems.SetMatrix(e+1, 2, p01)
+		}
+	}
+	if order == 2 {
+		return goNum.FEMMeshP2(nodes, elems)
+	}
+	return nodes, elems
+}
+
+func BenchmarkFEMMesh2D(b *testing.B) {
+	x78 := goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 1.0})
+	for i := 0; i < b.N; i++ {
+		goNum.FEMMesh2D(x78, 20, 20, 2)
+	}
+}
@@ -0,0 +1,80 @@
+// FEMMeshP2_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    由二维三角形线性P1网格生成二次P2网格
+理论：
+    对P1网格的每条边取中点作为新节点（相邻单元共用），
+    单元节点顺序为(v0, v1, v2, m01, m12, m20)，mij为顶点vi、vj
+    所在边的中点，新节点依次编号于原节点之后。
+------------------------------------------------------
+输入   :
+    nodes   P1节点坐标，Nx2
+    elems   P1单元节点编号，Ex3
+输出   :
+    nodes2  P2节点坐标，N2x2
+    elems2  P2单元节点编号，Ex6
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// FEMMeshP2 由二维三角形线性P1网格生成二次P2网格
+func FEMMeshP2(nodes, elems goNum.Matrix) (goNum.Matrix, goNum.Matrix) {
+	/*
+		由二维三角形线性P1网格生成二次P2网格
+		输入   :
+		    nodes   P1节点坐标，Nx2
+		    elems   P1单元节点编号，Ex3
+		输出   :
+		    nodes2  P2节点坐标，N2x2
+		    elems2  P2单元节点编号，Ex6
+	*/
+	//判断网格
+	if (nodes.Columns != 2) || (elems.Columns != 3) {
+		panic("Error in goNum.FEMMeshP2: nodes or elems is not a P1 triangular mesh")
+	}
+
+	E := elems.Rows
+	elems2 := goNum.ZeroMatrix(E, 6)
+	//边中点编号
+	mid := make(map[[2]int]int)
+	xy := append([]float64{}, nodes.Data...)
+	for e := 0; e < E; e++ {
+		for k := 0; k < 3; k++ {
+			v0 := int(elems.GetFromMatrix(e, k))
+			v1 := int(elems.GetFromMatrix(e, (k+1)%3))
+			elems2.SetMatrix(e, k, float64(v0))
+			key := [2]int{v0, v1}
+			if v0 > v1 {
+				key = [2]int{v1, v0}
+			}
+			id, ok := mid[key]
+			if !ok {
+				id = len(xy) / 2
+				mid[key] = id
+				xy = append(xy, (nodes.Data[2*v0]+nodes.Data[2*v1])/2.0,
+					(nodes.Data[2*v0+1]+nodes.Data[2*v1+1])/2.0)
+			}
+			elems2.SetMatrix(e, 3+k, float64(id))
+		}
+	}
+	return goNum.NewMatrix(len(xy)/2, 2, xy), elems2
+}
+
+func BenchmarkFEMMeshP2(b *testing.B) {
+	x78 := goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 1.0})
+	nodes78, elems78 := goNum.FEMMesh2D(x78, 20, 20, 1)
+	for i := 0; i < b.N; i++ {
+		goNum.FEMMeshP2(nodes78, elems78)
+	}
+}
@@ -0,0 +1,314 @@
+// GaussRule
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    任意点数Gauss型求积公式（Golub-Welsch算法）的节点、权重
+    类型及其操作
+理论：
+    对于权函数w(x) >= 0，区间(Lo, Hi)上的n点Gauss型求积公式
+    Hi                n
+    |w(x)f(x)dx ~= Sum Wi*f(Xi)
+    Lo               i=1
+    代数精度2n-1。首一正交多项式满足三项递推：
+    p_(k+1)(x) = (x - ak)pk(x) - bk*p_(k-1)(x)
+    Golub-Welsch：Xi为对称三对角Jacobi矩阵
+        | a0        sqrt(b1)                        |
+    J = | sqrt(b1)  a1        sqrt(b2)              |
+        |           ...       ...       sqrt(b_(n-1)) |
+        |                     sqrt(b_(n-1))  a_(n-1)  |
+    的特征值，由隐式位移QL方法求得，权重Wi = mu0*vi^2，vi为
+    Xi对应的单位特征向量的第一个分量。为提高精度，再以标准
+    正交多项式qn的Newton迭代修正节点，并以Christoffel数求权重：
+    Wi = 1/Sum_(k=0)^(n-1) qk(Xi)^2，qk为标准正交多项式，
+    q0 = 1/sqrt(mu0)，mu0 = ∫w(x)dx。
+
+    各权函数的递推系数：
+    1. Legendre，w = 1，[-1, 1]：ak = 0，bk = k^2/(4k^2-1)，mu0 = 2
+    2. Chebyshev（第一类），w = 1/sqrt(1-x^2)，[-1, 1]：
+       ak = 0，b1 = 1/2，bk = 1/4 (k>1)，mu0 = pi
+    3. 广义Laguerre，w = x^alpha*e^(-x)，[0, +Inf)：
+       ak = 2k+alpha+1，bk = k(k+alpha)，mu0 = Gamma(alpha+1)
+    4. Hermite，w = e^(-x^2)，(-Inf, +Inf)：
+       ak = 0，bk = k/2，mu0 = sqrt(pi)
+    5. Jacobi，w = (1-x)^alpha*(1+x)^beta，[-1, 1]，s = alpha+beta：
+       ak = (beta^2-alpha^2)/((2k+s)(2k+s+2))，
+       bk = 4k(k+alpha)(k+beta)(k+s)/((2k+s)^2(2k+s+1)(2k+s-1))，
+       mu0 = 2^(s+1)Gamma(alpha+1)Gamma(beta+1)/Gamma(s+2)
+
+    参考 G.H. Golub and J.H. Welsch. Calculation of Gauss
+         quadrature rules. Math. Comp., 1969, 23(106): 221-230.
+------------------------------------------------------
+注意事项：
+    1. IntegrateAB仅适用于有限区间上的公式，将[Lo, Hi]线性映射
+       至[a, b]，相应地权函数亦随之映射
+    2. Laguerre、Hermite公式在n较大时，远端节点的权重可能下溢
+       为零，不影响求积结果
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+	"sort"
+)
+
+//数据结构定义----------------------------------------+
+// GaussRule 定义Gauss型求积公式的节点、权重类型
+type GaussRule struct {
+	X, W   []float64 //节点（升序）与权重
+	Lo, Hi float64   //积分区间，可为正负无穷
+}
+
+//对称三对角矩阵隐式位移QL方法，d为对角元，e为次对角元(e[n-1]不用)，
+//返回特征值与特征向量的第一个分量
+func tqli_GaussRule(d, e []float64) ([]float64, []float64, bool) {
+	n := len(d)
+	z := make([]float64, n)
+	z[0] = 1.0
+	e[n-1] = 0.0
+	for l := 0; l < n; l++ {
+		for iter := 0; ; iter++ {
+			m := l
+			for ; m < n-1; m++ {
+				dd := math.Abs(d[m]) + math.Abs(d[m+1])
+				if math.Abs(e[m]) <= 2.220446049250313e-16*dd {
+					break
+				}
+			}
+			if m == l {
+				break
+			}
+			if iter == 60 {
+				return d, z, false
+			}
+			g := (d[l+1] - d[l]) / (2.0 * e[l])
+			r := math.Hypot(g, 1.0)
+			g = d[m] - d[l] + e[l]/(g+math.Copysign(r, g))
+			s, c, p := 1.0, 1.0, 0.0
+			i := m - 1
+			for ; i >= l; i-- {
+				f := s * e[i]
+				b := c * e[i]
+				r = math.Hypot(f, g)
+				e[i+1] = r
+				if r == 0.0 {
+					d[i+1] -= p
+					e[m] = 0.0
+					break
+				}
+				s = f / r
+				c = g / r
+				g = d[i+1] - p
+				r = (d[i]-g)*s + 2.0*c*b
+				p = s * r
+				d[i+1] = g + p
+				g = c*r - b
+				//特征向量第一个分量
+				f = z[i+1]
+				z[i+1] = s*z[i] + c*f
+				z[i] = c*z[i] - s*f
+			}
+			if (r == 0.0) && (i >= l) {
+				continue
+			}
+			d[l] -= p
+			e[l] = g
+			e[m] = 0.0
+		}
+	}
+	return d, z, true
+}
+
+// GaussRuleGolubWelsch 由首一正交多项式的递推系数ak、bk(k = 0, ..., n-1，
+//b[0]不用)与mu0创建n点Gauss型求积公式，lo、hi为积分区间
+func GaussRuleGolubWelsch(a, b []float64, mu0, lo, hi float64) GaussRule {
+	n := len(a)
+	if (n < 1) || (len(b) != n) {
+		panic("Error in goNum.GaussRuleGolubWelsch: Length of a or b error")
+	}
+	for k := 1; k < n; k++ {
+		if b[k] <= 0.0 {
+			panic("Error in goNum.GaussRuleGolubWelsch: b is not positive")
+		}
+	}
+
+	d := append([]float64{}, a...)
+	e := make([]float64, n)
+	for k := 0; k < n-1; k++ {
+		e[k] = math.Sqrt(b[k+1])
+	}
+	x, z, ok := tqli_GaussRule(d, e)
+	if !ok {
+		panic("Error in goNum.GaussRuleGolubWelsch: Eigenvalue iteration not converged")
+	}
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool { return x[idx[i]] < x[idx[j]] })
+
+	//标准正交多项式qn(t)、qn'(t)与Sum_(k=0)^(n-1) qk(t)^2，
+	//qn只用于Newton修正，取sqrt(bn) = 1不影响修正量
+	eval := func(t float64) (float64, float64, float64) {
+		q0, dq0 := 1.0/math.Sqrt(mu0), 0.0
+		var q1, dq1 float64
+		sum := q0 * q0
+		for k := 0; k < n; k++ {
+			bk, bk1 := 0.0, 1.0
+			if k > 0 {
+				bk = math.Sqrt(b[k])
+			}
+			if k < n-1 {
+				bk1 = math.Sqrt(b[k+1])
+			}
+			q2 := ((t-a[k])*q0 - bk*q1) / bk1
+			dq2 := (q0 + (t-a[k])*dq0 - bk*dq1) / bk1
+			q1, dq1 = q0, dq0
+			q0, dq0 = q2, dq2
+			if k < n-1 {
+				sum += q0 * q0
+			}
+		}
+		return q0, dq0, sum
+	}
+	X := make([]float64, n)
+	W := make([]float64, n)
+	for i, j := range idx {
+		X[i] = x[j]
+		//Newton修正
+		for k := 0; k < 2; k++ {
+			qn, dqn, _ := eval(X[i])
+			dx := qn / dqn
+			if math.IsNaN(dx) || (math.Abs(dx) > 1e-8*(1.0+math.Abs(X[i]))) {
+				break
+			}
+			X[i] -= dx
+		}
+		//Christoffel数，溢出时取mu0*z^2
+		_, _, sum := eval(X[i])
+		W[i] = 1.0 / sum
+		if math.IsNaN(sum) || math.IsInf(sum, 0) {
+			W[i] = mu0 * z[j] * z[j]
+		}
+	}
+	return GaussRule{X, W, lo, hi}
+}
+
+//Gauss型求积公式----------------------------------------+
+// GaussRuleLegendre n点Gauss-Legendre公式，w = 1，[-1, 1]
+func GaussRuleLegendre(n int) GaussRule {
+	if n < 1 {
+		panic("Error in goNum.GaussRuleLegendre: n less than 1")
+	}
+	a := make([]float64, n)
+	b := make([]float64, n)
+	for k := 1; k < n; k++ {
+		fk := float64(k)
+		b[k] = fk * fk / (4.0*fk*fk - 1.0)
+	}
+	return GaussRuleGolubWelsch(a, b, 2.0, -1.0, 1.0)
+}
+
+// GaussRuleChebyshev n点Gauss-Chebyshev公式，w = 1/sqrt(1-x^2)，[-1, 1]
+func GaussRuleChebyshev(n int) GaussRule {
+	if n < 1 {
+		panic("Error in goNum.GaussRuleChebyshev: n less than 1")
+	}
+	a := make([]float64, n)
+	b := make([]float64, n)
+	for k := 1; k < n; k++ {
+		b[k] = 0.25
+	}
+	if n > 1 {
+		b[1] = 0.5
+	}
+	return GaussRuleGolubWelsch(a, b, math.Pi, -1.0, 1.0)
+}
+
+// GaussRuleLaguerre n点广义Gauss-Laguerre公式，w = x^alpha*e^(-x)，[0, +Inf)
+func GaussRuleLaguerre(n int, alpha float64) GaussRule {
+	if n < 1 {
+		panic("Error in goNum.GaussRuleLaguerre: n less than 1")
+	}
+	if alpha <= -1.0 {
+		panic("Error in goNum.GaussRuleLaguerre: alpha less than or equals to -1")
+	}
+	a := make([]float64, n)
+	b := make([]float64, n)
+	for k := 0; k < n; k++ {
+		fk := float64(k)
+		a[k] = 2.0*fk + alpha + 1.0
+		b[k] = fk * (fk + alpha)
+	}
+	return GaussRuleGolubWelsch(a, b, math.Gamma(alpha+1.0), 0.0, math.Inf(1))
+}
+
+// GaussRuleHermite n点Gauss-Hermite公式，w = e^(-x^2)，(-Inf, +Inf)
+func GaussRuleHermite(n int) GaussRule {
+	if n < 1 {
+		panic("Error in goNum.GaussRuleHermite: n less than 1")
+	}
+	a := make([]float64, n)
+	b := make([]float64, n)
+	for k := 1; k < n; k++ {
+		b[k] = float64(k) / 2.0
+	}
+	return GaussRuleGolubWelsch(a, b, math.Sqrt(math.Pi), math.Inf(-1), math.Inf(1))
+}
+
+// GaussRuleJacobi n点Gauss-Jacobi公式，w = (1-x)^alpha*(1+x)^beta，[-1, 1]
+func GaussRuleJacobi(n int, alpha, beta float64) GaussRule {
+	if n < 1 {
+		panic("Error in goNum.GaussRuleJacobi: n less than 1")
+	}
+	if (alpha <= -1.0) || (beta <= -1.0) {
+		panic("Error in goNum.GaussRuleJacobi: alpha or beta less than or equals to -1")
+	}
+	s := alpha + beta
+	a := make([]float64, n)
+	b := make([]float64, n)
+	a[0] = (beta - alpha) / (s + 2.0)
+	for k := 1; k < n; k++ {
+		fk := float64(k)
+		t := 2.0*fk + s
+		a[k] = (beta*beta - alpha*alpha) / (t * (t + 2.0))
+		if k == 1 {
+			//避免s = -1时的0/0
+			b[k] = 4.0 * (1.0 + alpha) * (1.0 + beta) / ((2.0 + s) * (2.0 + s) * (3.0 + s))
+		} else {
+			b[k] = 4.0 * fk * (fk + alpha) * (fk + beta) * (fk + s) / (t * t * (t + 1.0) * (t - 1.0))
+		}
+	}
+	lg1, _ := math.Lgamma(alpha + 1.0)
+	lg2, _ := math.Lgamma(beta + 1.0)
+	lg3, _ := math.Lgamma(s + 2.0)
+	mu0 := math.Exp((s+1.0)*math.Ln2 + lg1 + lg2 - lg3)
+	return GaussRuleGolubWelsch(a, b, mu0, -1.0, 1.0)
+}
+
+//求积公式操作-------------------------------------------+
+// Integrate 求积Sum Wi*f(Xi)，即∫w(x)f(x)dx
+func (G *GaussRule) Integrate(fun func(float64) float64) float64 {
+	var sol float64
+	for i := range G.X {
+		sol += G.W[i] * fun(G.X[i])
+	}
+	return sol
+}
+
+// IntegrateAB 将有限区间[Lo, Hi]线性映射至[a, b]后求积
+func (G *GaussRule) IntegrateAB(fun func(float64) float64, a, b float64) float64 {
+	if math.IsInf(G.Lo, 0) || math.IsInf(G.Hi, 0) {
+		panic("Error in goNum.GaussRule.IntegrateAB: Rule on infinite interval")
+	}
+	c := (b - a) / (G.Hi - G.Lo)
+	var sol float64
+	for i := range G.X {
+		sol += G.W[i] * fun(a+c*(G.X[i]-G.Lo))
+	}
+	return c * sol
+}
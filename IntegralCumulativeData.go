@@ -0,0 +1,84 @@
+// IntegralCumulativeData
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    离散数据（可非等距）的累积积分
+理论：
+    对于N个数据点(xi, yi)，x0 < x1 < ... < x(N-1)，求
+           xi
+    Fi = |y(x)dx，i = 0, 1, ..., N-1，F0 = 0
+           x0
+    Fi = F(i-1) + I_i，I_i为区间[x(i-1), xi]上的积分：
+    1. method = 1：梯形公式（同IntegralTrapezoidData）；
+    2. method = 2：抛物线分段（同IntegralSimpsonData），
+       [x(2k), x(2k+1)]、[x(2k+1), x(2k+2)]分别取过
+       x(2k)、x(2k+1)、x(2k+2)的抛物线的积分，奇数个区间时
+       最后一个区间取过末三点的抛物线的积分；
+    3. method = 3：not-a-knot三次样条（同IntegralSplineData）。
+    F(N-1)与相应的定积分函数结果相同。
+------------------------------------------------------
+输入   :
+    xy      单自变量单因变量的N个数据对，Nx2，第一列x严格递增，
+            N >= 2
+    method  1-梯形公式，2-Simpson公式，3-三次样条
+输出   :
+    sol     累积积分，Nx2，第一列xi，第二列Fi
+    err     解出标志：false-未解出；true-解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+// IntegralCumulativeData 离散数据（可非等距）的累积积分
+func IntegralCumulativeData(xy Matrix, method int) (Matrix, bool) {
+	/*
+		离散数据（可非等距）的累积积分
+		输入   :
+		    xy      单自变量单因变量的N个数据对，Nx2，第一列x严格递增，
+		            N >= 2
+		    method  1-梯形公式，2-Simpson公式，3-三次样条
+		输出   :
+		    sol     累积积分，Nx2，第一列xi，第二列Fi
+		    err     解出标志：false-未解出；true-解出
+	*/
+	check_IntegralTrapezoidData(xy, "IntegralCumulativeData")
+
+	var err bool = false
+	N := xy.Rows
+	var I []float64
+	switch method {
+	case 1:
+		I = make([]float64, N-1)
+		for i := 1; i < N; i++ {
+			hi := xy.GetFromMatrix(i, 0) - xy.GetFromMatrix(i-1, 0)
+			I[i-1] = hi * (xy.GetFromMatrix(i-1, 1) + xy.GetFromMatrix(i, 1)) / 2.0
+		}
+	case 2:
+		I = intervals_IntegralSimpsonData(xy)
+	case 3:
+		I = intervals_IntegralSplineData(xy)
+	default:
+		panic("Error in goNum.IntegralCumulativeData: method is not 1, 2 or 3")
+	}
+
+	sol := ZeroMatrix(N, 2)
+	for i := 0; i < N; i++ {
+		sol.SetMatrix(i, 0, xy.GetFromMatrix(i, 0))
+		if i > 0 {
+			sol.SetMatrix(i, 1, sol.GetFromMatrix(i-1, 1)+I[i-1])
+		}
+	}
+
+	if math.IsNaN(sol.GetFromMatrix(N-1, 1)) || math.IsInf(sol.GetFromMatrix(N-1, 1), 0) {
+		return sol, err
+	}
+	err = true
+	return sol, err
+}
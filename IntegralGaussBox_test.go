@@ -0,0 +1,133 @@
+// IntegralGaussBox_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    长方体（矩形）区域上的复化累次Gauss-Legendre求积分公式
+理论：
+    对于d重积分
+    b1   b2        bd
+    |dx1 |dx2 ... |f(x1, x2, ..., xd)dxd
+    a1   a2        ad
+
+    各维[ai, bi]等分为m个子区间，每个子区间取n点Gauss-Legendre
+    公式（GaussRuleLegendre），得该维的m*n个节点xik与权重wik，
+    累次求积即张量积公式
+                m*n   m*n
+    I ~= Sum ... Sum w1k1*...*wdkd*f(x1k1, ..., xdkd)
+                k1=1  kd=1
+    函数值计算次数(m*n)^d，适用于维数较低的光滑被积函数。
+------------------------------------------------------
+输入   :
+    fun     被积分函数，自变量为dx1向量
+    a, b    积分区间下限、上限，dx1向量
+    n       每个子区间的Gauss点数
+    m       每维子区间数
+输出   :
+    sol     解
+    err     解出标志：false-未解出；true-解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// IntegralGaussBox 长方体（矩形）区域上的复化累次Gauss-Legendre求积分公式
+func IntegralGaussBox(fun func(goNum.Matrix) float64, a, b goNum.Matrix, n, m int) (float64, bool) {
+	/*
+		长方体（矩形）区域上的复化累次Gauss-Legendre求积分公式
+		输入   :
+		    fun     被积分函数，自变量为dx1向量
+		    a, b    积分区间下限、上限，dx1向量
+		    n       每个子区间的Gauss点数
+		    m       每维子区间数
+		输出   :
+		    sol     解
+		    err     解出标志：false-未解出；true-解出
+	*/
+	//判断区间
+	d := a.Rows
+	if (a.Columns != 1) || (b.Columns != 1) || (b.Rows != d) || (d < 1) {
+		panic("Error in goNum.IntegralGaussBox: a or b is not a dx1 vector")
+	}
+	for i := 0; i < d; i++ {
+		if math.IsInf(a.Data[i], 0) || math.IsInf(b.Data[i], 0) {
+			panic("Error in goNum.IntegralGaussBox: Interval is not finite")
+		}
+	}
+	//判断点数与子区间数
+	if (n < 1) || (m < 1) {
+		panic("Error in goNum.IntegralGaussBox: n or m less than 1")
+	}
+
+	var err bool = false
+	//各维复化节点与权重
+	G := goNum.GaussRuleLegendre(n)
+	xs := make([][]float64, d)
+	ws := make([][]float64, d)
+	for i := 0; i < d; i++ {
+		h := (b.Data[i] - a.Data[i]) / float64(m)
+		for j := 0; j < m; j++ {
+			c := a.Data[i] + (float64(j)+0.5)*h
+			for k := 0; k < n; k++ {
+				xs[i] = append(xs[i], c+h/2.0*G.X[k])
+				ws[i] = append(ws[i], h/2.0*G.W[k])
+			}
+		}
+	}
+
+	//逐点累加，idx为各维节点序号
+	idx := make([]int, d)
+	x := goNum.ZeroMatrix(d, 1)
+	var sol float64
+	for {
+		w := 1.0
+		for i := 0; i < d; i++ {
+			x.Data[i] = xs[i][idx[i]]
+			w *= ws[i][idx[i]]
+		}
+		sol += w * fun(x)
+		//下一个节点
+		i := d - 1
+		for ; i >= 0; i-- {
+			idx[i]++
+			if idx[i] < m*n {
+				break
+			}
+			idx[i] = 0
+		}
+		if i < 0 {
+			break
+		}
+	}
+
+	if math.IsNaN(sol) || math.IsInf(sol, 0) {
+		return sol, err
+	}
+	err = true
+	return sol, err
+}
+
+func fun94(x goNum.Matrix) float64 {
+	var sum float64
+	for _, v := range x.Data {
+		sum += (v - 0.3) * (v - 0.3)
+	}
+	return math.Exp(-10.0 * sum)
+}
+
+func BenchmarkIntegralGaussBox(b *testing.B) {
+	a94 := goNum.ZeroMatrix(3, 1)
+	b94 := goNum.Slices1ToMatrix([]float64{1.0, 1.0, 1.0})
+	for i := 0; i < b.N; i++ {
+		goNum.IntegralGaussBox(fun94, a94, b94, 8, 2)
+	}
+}
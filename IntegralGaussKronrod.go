@@ -0,0 +1,296 @@
+// IntegralGaussKronrod
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    全局自适应Gauss-Kronrod求积分公式（含epsilon算法外推）
+理论：
+    对于积分
+    b
+    |f(x)dx
+    a
+
+    1. 子区间[a, b]上以n点Gauss公式G与2n+1点Kronrod公式K（含
+       G的全部节点）求积，key = 1为G7-K15，key = 2为G10-K21，
+       K的代数精度为3n+1。误差估计（QUADPACK）：
+       e = I*min(1, (200|K - G|/I)^1.5)，I = ∫|f - K/(b-a)|dx
+       且e不小于50*eps*∫|f|dx。
+    2. 全局自适应：每次二分误差估计最大的子区间，直至各子区间
+       误差估计之和E <= max(epsabs, epsrel*|Q|)或子区间数达到
+       limit。
+    3. 外推：端点奇异时，各次加密在奇点附近几何地缩小子区间，
+       积分近似序列Q_0, Q_1, ...近似满足Q_k ~ Q + c*q^k。每当
+       最小子区间的层数增加，先二分较大（层数较小）的子区间使
+       其误差之和不大于容许误差，再将当前Q加入序列，以Wynn
+       epsilon算法外推：
+       e_(-1)^(k) = 0，e_0^(k) = Q_k，
+       e_(j+1)^(k) = e_(j-1)^(k+1) + 1/(e_j^(k+1) - e_j^(k))
+       取最高偶数列的元素为外推值，以最近三次外推值之差估计误差。
+       外推值的误差估计小于E时取外推值。
+
+    参考 R. Piessens, E. de Doncker-Kapenga, C.W. Uberhuber
+         and D.K. Kahaner. QUADPACK: A Subroutine Package for
+         Automatic Integration. Springer, 1983. ss 2.2, 4.
+------------------------------------------------------
+输入   :
+    fun     被积分函数
+    a, b    积分区间
+    key     1-G7-K15，2-G10-K21
+    epsabs  绝对误差上限
+    epsrel  相对误差上限
+    limit   子区间数上限
+输出   :
+    sol     解
+    abserr  误差估计
+    neval   函数值计算次数
+    err     解出标志：false-未解出或达到子区间数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+//Gauss-Kronrod节点与权重，节点降序，奇数位置(1, 3, ...)为Gauss节点
+var (
+	xgk15_IntegralGaussKronrod = []float64{
+		0.991455371120812639206854697526329, 0.949107912342758524526189684047851,
+		0.864864423359769072789712788640926, 0.741531185599394439863864773280788,
+		0.586087235467691130294144845693013, 0.405845151377397166906606412076961,
+		0.207784955007898467600689403773245, 0.0}
+	wgk15_IntegralGaussKronrod = []float64{
+		0.022935322010529224963732008058970, 0.063092092629978553290700663189204,
+		0.104790010322250183839876322541518, 0.140653259715525918745189590510238,
+		0.169004726639267902826583426598550, 0.190350578064785409913256402421014,
+		0.204432940075298892414161999234649, 0.209482141084727828012999174891714}
+	wg7_IntegralGaussKronrod = []float64{
+		0.129484966168869693270611432679082, 0.279705391489276667901467771423780,
+		0.381830050505118944950369775488975, 0.417959183673469387755102040816327}
+	xgk21_IntegralGaussKronrod = []float64{
+		0.995657163025808080735527280689003, 0.973906528517171720077964012084452,
+		0.930157491355708226001207180059508, 0.865063366688984510732096688423493,
+		0.780817726586416897063717578345042, 0.679409568299024406234327365114874,
+		0.562757134668604683339000099272694, 0.433395394129247190799265943165784,
+		0.294392862701460198131126603103866, 0.148874338981631210884826001129720, 0.0}
+	wgk21_IntegralGaussKronrod = []float64{
+		0.011694638867371874278064396062192, 0.032558162307964727478818972459390,
+		0.054755896574351996031381300244580, 0.075039674810919952767043140916190,
+		0.093125454583697605535065465083366, 0.109387158802297641899210590325805,
+		0.123491976262065851077208795457989, 0.134709217311473325928054001771707,
+		0.142775938577060080797094273138717, 0.147739104901338491374841515972068,
+		0.149445554002916905664936468389821}
+	wg10_IntegralGaussKronrod = []float64{
+		0.066671344308688137593568809893332, 0.149451349150580593145776339657697,
+		0.219086362515982043995534934228163, 0.269266719309996355091226921569469,
+		0.295524224714752870173892994651338}
+)
+
+//子区间
+type interval_IntegralGaussKronrod struct {
+	a, b, r, e float64 //端点、积分值与误差估计
+	level      int     //二分层数
+}
+
+//[a, b]上的Gauss-Kronrod公式，返回积分值与误差估计
+func rule_IntegralGaussKronrod(fun func(float64) float64, a, b float64, key int) (float64, float64) {
+	xgk, wgk, wg := xgk15_IntegralGaussKronrod, wgk15_IntegralGaussKronrod, wg7_IntegralGaussKronrod
+	if key == 2 {
+		xgk, wgk, wg = xgk21_IntegralGaussKronrod, wgk21_IntegralGaussKronrod, wg10_IntegralGaussKronrod
+	}
+	n := len(xgk) - 1
+	c := (a + b) / 2.0
+	h := (b - a) / 2.0
+	fc := fun(c)
+	fv1 := make([]float64, n)
+	fv2 := make([]float64, n)
+	resk := wgk[n] * fc
+	resabs := math.Abs(resk)
+	var resg float64
+	if n%2 == 1 {
+		//Gauss公式含中点
+		resg = wg[n/2] * fc
+	}
+	for j := 0; j < n; j++ {
+		fv1[j] = fun(c - h*xgk[j])
+		fv2[j] = fun(c + h*xgk[j])
+		resk += wgk[j] * (fv1[j] + fv2[j])
+		resabs += wgk[j] * (math.Abs(fv1[j]) + math.Abs(fv2[j]))
+		if j%2 == 1 {
+			resg += wg[j/2] * (fv1[j] + fv2[j])
+		}
+	}
+	reskh := resk / 2.0
+	resasc := wgk[n] * math.Abs(fc-reskh)
+	for j := 0; j < n; j++ {
+		resasc += wgk[j] * (math.Abs(fv1[j]-reskh) + math.Abs(fv2[j]-reskh))
+	}
+	h = math.Abs(h)
+	resasc *= h
+	resabs *= h
+	e := math.Abs((resk - resg) * h)
+	if (resasc != 0.0) && (e != 0.0) {
+		e = resasc * math.Min(1.0, math.Pow(200.0*e/resasc, 1.5))
+	}
+	if resabs > math.SmallestNonzeroFloat64/(50.0*2.220446049250313e-16) {
+		e = math.Max(50.0*2.220446049250313e-16*resabs, e)
+	}
+	return resk * (b - a) / 2.0, e
+}
+
+//Wynn epsilon算法，返回序列的外推值
+func epsilon_IntegralGaussKronrod(s []float64) float64 {
+	n := len(s)
+	prev := make([]float64, n+1) //e_(j-1)列
+	cur := append([]float64{}, s...)
+	sol := s[n-1]
+	for j := 1; j < n; j++ {
+		next := make([]float64, len(cur)-1)
+		for k := 0; k < len(next); k++ {
+			d := cur[k+1] - cur[k]
+			if d == 0.0 {
+				return sol
+			}
+			next[k] = prev[k+1] + 1.0/d
+		}
+		prev, cur = cur, next
+		if j%2 == 0 {
+			//偶数列末元素
+			sol = cur[len(cur)-1]
+		}
+	}
+	return sol
+}
+
+// IntegralGaussKronrod 全局自适应Gauss-Kronrod求积分公式（含epsilon算法外推）
+func IntegralGaussKronrod(fun func(float64) float64, a, b float64, key int,
+	epsabs, epsrel float64, limit int) (float64, float64, int, bool) {
+	/*
+		全局自适应Gauss-Kronrod求积分公式（含epsilon算法外推）
+		输入   :
+		    fun     被积分函数
+		    a, b    积分区间
+		    key     1-G7-K15，2-G10-K21
+		    epsabs  绝对误差上限
+		    epsrel  相对误差上限
+		    limit   子区间数上限
+		输出   :
+		    sol     解
+		    abserr  误差估计
+		    neval   函数值计算次数
+		    err     解出标志：false-未解出或达到子区间数上限；
+		                     true-全部解出
+	*/
+	//判断key
+	if (key != 1) && (key != 2) {
+		panic("Error in goNum.IntegralGaussKronrod: key is not 1 or 2")
+	}
+	//判断误差与子区间数
+	if (epsabs < 0.0) || (epsrel < 0.0) || ((epsabs == 0.0) && (epsrel == 0.0)) || (limit < 1) {
+		panic("Error in goNum.IntegralGaussKronrod: Tolerance or limit error")
+	}
+
+	var err bool = false
+	npt := 15
+	if key == 2 {
+		npt = 21
+	}
+	neval := 0
+	rule := func(a0, b0 float64) (float64, float64) {
+		neval += npt
+		return rule_IntegralGaussKronrod(fun, a0, b0, key)
+	}
+
+	r0, e0 := rule(a, b)
+	list := []interval_IntegralGaussKronrod{{a, b, r0, e0, 0}}
+	sol, abserr := r0, e0
+	if abserr <= math.Max(epsabs, epsrel*math.Abs(sol)) {
+		err = true
+		return sol, abserr, neval, err
+	}
+
+	//外推状态
+	seq := []float64{r0}               //积分近似序列
+	var res3 []float64                 //最近三次外推值
+	resext, errext := 0.0, math.Inf(1) //外推值及其误差估计
+	maxlevel := 0
+	pending := false //最小子区间层数增加后尚未加入序列
+	for len(list) < limit {
+		tol := math.Max(epsabs, epsrel*math.Abs(sol))
+		//较大子区间的误差之和
+		var erlarge float64
+		for _, v := range list {
+			if v.level < maxlevel {
+				erlarge += v.e
+			}
+		}
+		//选取待二分的子区间
+		idx := -1
+		for i, v := range list {
+			if pending && (erlarge > tol) && (v.level >= maxlevel) {
+				continue
+			}
+			if (idx < 0) || (v.e > list[idx].e) {
+				idx = i
+			}
+		}
+		if pending && (erlarge <= tol) {
+			//加入序列并外推
+			pending = false
+			seq = append(seq, sol)
+			if len(seq) > 25 {
+				seq = seq[1:]
+			}
+			if len(seq) >= 3 {
+				temp0 := epsilon_IntegralGaussKronrod(seq)
+				res3 = append(res3, temp0)
+				if len(res3) > 3 {
+					res3 = res3[1:]
+				}
+				if len(res3) == 3 {
+					e := math.Abs(temp0-res3[0]) + math.Abs(temp0-res3[1])
+					e = math.Max(e, 5.0*2.220446049250313e-16*math.Abs(temp0))
+					if e < errext {
+						resext, errext = temp0, e
+					}
+					if errext <= tol {
+						err = true
+						return resext, errext, neval, err
+					}
+				}
+			}
+			continue
+		}
+
+		//二分
+		v := list[idx]
+		m := (v.a + v.b) / 2.0
+		if (m <= math.Min(v.a, v.b)) || (m >= math.Max(v.a, v.b)) {
+			//子区间过小，达到机器精度
+			break
+		}
+		r1, e1 := rule(v.a, m)
+		r2, e2 := rule(m, v.b)
+		sol += r1 + r2 - v.r
+		abserr += e1 + e2 - v.e
+		list[idx] = interval_IntegralGaussKronrod{v.a, m, r1, e1, v.level + 1}
+		list = append(list, interval_IntegralGaussKronrod{m, v.b, r2, e2, v.level + 1})
+		if v.level+1 > maxlevel {
+			maxlevel = v.level + 1
+			pending = true
+		}
+		//解出
+		if abserr <= math.Max(epsabs, epsrel*math.Abs(sol)) {
+			err = true
+			return sol, abserr, neval, err
+		}
+	}
+	if errext < abserr {
+		return resext, errext, neval, err
+	}
+	return sol, abserr, neval, err
+}
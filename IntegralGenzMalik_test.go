@@ -0,0 +1,243 @@
+// IntegralGenzMalik_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    长方体区域上的Genz-Malik全局自适应求积分公式
+理论：
+    对于d重积分(2 <= d <= 约10)
+    b1   b2        bd
+    |dx1 |dx2 ... |f(x1, x2, ..., xd)dxd
+    a1   a2        ad
+
+    1. 以中心c、半宽h的子区域上，x = c + h*u，u∈[-1, 1]^d，
+       7次Genz-Malik公式
+       R7 = V*(w1*f(0) + w2*Sum f(±l2*ei) + w3*Sum f(±l4*ei)
+            + w4*Sum f(±l4*ei±l4*ej) + w5*Sum f(±l5, ..., ±l5))
+       V为子区域体积，l2 = sqrt(9/70)，l4 = sqrt(9/10)，
+       l5 = sqrt(9/19)，
+       w1 = (12824-9120d+400d^2)/19683，w2 = 980/6561，
+       w3 = (1820-400d)/19683，w4 = 200/19683，
+       w5 = 6859/19683/2^d；
+       嵌入的5次公式R5（不含l5点）：
+       w1' = (729-950d+50d^2)/729，w2' = 245/486，
+       w3' = (265-100d)/1458，w4' = 25/729。
+       每个子区域计算2^d+2d^2+2d+1次函数值，误差估计|R7-R5|。
+    2. 二分方向：取各维四阶差分
+       Di = |f(l2*ei)+f(-l2*ei)-2f(0) - (l2/l4)^2*(f(l4*ei)+f(-l4*ei)-2f(0))|
+       最大的维（相等时取较宽的维）。
+    3. 全局自适应：每次二分误差估计最大的子区域，直至误差估计
+       之和不大于max(epsabs, epsrel*|I|)或函数值计算次数将超过
+       maxeval。
+
+    参考 A.C. Genz and A.A. Malik. Remarks on algorithm 006: An
+         adaptive algorithm for numerical integration over an
+         N-dimensional rectangular region. J. Comput. Appl.
+         Math., 1980, 6(4): 295-302.
+------------------------------------------------------
+输入   :
+    fun     被积分函数，自变量为dx1向量
+    a, b    积分区间下限、上限，dx1向量，d >= 2
+    epsabs  绝对误差上限
+    epsrel  相对误差上限
+    maxeval 函数值计算次数上限
+输出   :
+    sol     解
+    abserr  误差估计
+    neval   函数值计算次数
+    err     解出标志：false-未解出或达到函数值计算次数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+//子区域
+type box_IntegralGenzMalik struct {
+	c, h []float64 //中心与半宽
+	r, e float64   //积分值与误差估计
+	dir  int       //二分方向
+}
+
+//子区域上的Genz-Malik公式，返回积分值、误差估计与二分方向
+func rule_IntegralGenzMalik(fun func(goNum.Matrix) float64, c, h []float64) (float64, float64, int) {
+	d := len(c)
+	fd := float64(d)
+	l2 := math.Sqrt(9.0 / 70.0)
+	l4 := math.Sqrt(9.0 / 10.0)
+	l5 := math.Sqrt(9.0 / 19.0)
+	x := goNum.ZeroMatrix(d, 1)
+	copy(x.Data, c)
+	vol := 1.0
+	for i := 0; i < d; i++ {
+		vol *= 2.0 * h[i]
+	}
+
+	f0 := fun(x)
+	var sum2, sum3, sum4, sum5 float64
+	dir, dmax := 0, -1.0
+	for i := 0; i < d; i++ {
+		x.Data[i] = c[i] - l2*h[i]
+		f2 := fun(x)
+		x.Data[i] = c[i] + l2*h[i]
+		f2 += fun(x)
+		x.Data[i] = c[i] - l4*h[i]
+		f3 := fun(x)
+		x.Data[i] = c[i] + l4*h[i]
+		f3 += fun(x)
+		x.Data[i] = c[i]
+		sum2 += f2
+		sum3 += f3
+		//四阶差分
+		diff := math.Abs(f2 - 2.0*f0 - (l2*l2)/(l4*l4)*(f3-2.0*f0))
+		if (diff > dmax) || ((diff == dmax) && (h[i] > h[dir])) {
+			dir, dmax = i, diff
+		}
+	}
+	for i := 0; i < d; i++ {
+		for j := i + 1; j < d; j++ {
+			for _, si := range []float64{-1.0, 1.0} {
+				for _, sj := range []float64{-1.0, 1.0} {
+					x.Data[i] = c[i] + si*l4*h[i]
+					x.Data[j] = c[j] + sj*l4*h[j]
+					sum4 += fun(x)
+				}
+			}
+			x.Data[i] = c[i]
+			x.Data[j] = c[j]
+		}
+	}
+	//2^d个顶点型节点
+	for k := 0; k < 1<<uint(d); k++ {
+		for i := 0; i < d; i++ {
+			if k&(1<<uint(i)) != 0 {
+				x.Data[i] = c[i] + l5*h[i]
+			} else {
+				x.Data[i] = c[i] - l5*h[i]
+			}
+		}
+		sum5 += fun(x)
+	}
+
+	r7 := (12824.0-9120.0*fd+400.0*fd*fd)/19683.0*f0 + 980.0/6561.0*sum2 +
+		(1820.0-400.0*fd)/19683.0*sum3 + 200.0/19683.0*sum4 + 6859.0/19683.0/math.Pow(2.0, fd)*sum5
+	r5 := (729.0-950.0*fd+50.0*fd*fd)/729.0*f0 + 245.0/486.0*sum2 +
+		(265.0-100.0*fd)/1458.0*sum3 + 25.0/729.0*sum4
+	return vol * r7, vol * math.Abs(r7-r5), dir
+}
+
+// IntegralGenzMalik 长方体区域上的Genz-Malik全局自适应求积分公式
+func IntegralGenzMalik(fun func(goNum.Matrix) float64, a, b goNum.Matrix,
+	epsabs, epsrel float64, maxeval int) (float64, float64, int, bool) {
+	/*
+		长方体区域上的Genz-Malik全局自适应求积分公式
+		输入   :
+		    fun     被积分函数，自变量为dx1向量
+		    a, b    积分区间下限、上限，dx1向量，d >= 2
+		    epsabs  绝对误差上限
+		    epsrel  相对误差上限
+		    maxeval 函数值计算次数上限
+		输出   :
+		    sol     解
+		    abserr  误差估计
+		    neval   函数值计算次数
+		    err     解出标志：false-未解出或达到函数值计算次数上限；
+		                     true-全部解出
+	*/
+	//判断区间
+	d := a.Rows
+	if (a.Columns != 1) || (b.Columns != 1) || (b.Rows != d) {
+		panic("Error in goNum.IntegralGenzMalik: a or b is not a dx1 vector")
+	}
+	if (d < 2) || (d > 30) {
+		panic("Error in goNum.IntegralGenzMalik: Dimension is not in [2, 30]")
+	}
+	for i := 0; i < d; i++ {
+		if math.IsInf(a.Data[i], 0) || math.IsInf(b.Data[i], 0) {
+			panic("Error in goNum.IntegralGenzMalik: Interval is not finite")
+		}
+	}
+	//判断误差与计算次数
+	if (epsabs < 0.0) || (epsrel < 0.0) || ((epsabs == 0.0) && (epsrel == 0.0)) {
+		panic("Error in goNum.IntegralGenzMalik: Tolerance error")
+	}
+	npt := 1<<uint(d) + 2*d*d + 2*d + 1
+	if maxeval < npt {
+		panic("Error in goNum.IntegralGenzMalik: maxeval less than points of one rule")
+	}
+
+	var err bool = false
+	c := make([]float64, d)
+	h := make([]float64, d)
+	sign := 1.0
+	for i := 0; i < d; i++ {
+		c[i] = (a.Data[i] + b.Data[i]) / 2.0
+		h[i] = (b.Data[i] - a.Data[i]) / 2.0
+		//上下限互换
+		if h[i] < 0.0 {
+			h[i] = -h[i]
+			sign = -sign
+		}
+	}
+	r0, e0, dir0 := rule_IntegralGenzMalik(fun, c, h)
+	neval := npt
+	list := []box_IntegralGenzMalik{{c, h, r0, e0, dir0}}
+	sol, abserr := r0, e0
+	for {
+		//解出
+		if abserr <= math.Max(epsabs, epsrel*math.Abs(sol)) {
+			err = true
+			return sign * sol, abserr, neval, err
+		}
+		if neval+2*npt > maxeval {
+			break
+		}
+		idx := 0
+		for i, v := range list {
+			if v.e > list[idx].e {
+				idx = i
+			}
+		}
+		//沿dir二分
+		v := list[idx]
+		h1 := append([]float64{}, v.h...)
+		h1[v.dir] /= 2.0
+		c1 := append([]float64{}, v.c...)
+		c2 := append([]float64{}, v.c...)
+		c1[v.dir] -= h1[v.dir]
+		c2[v.dir] += h1[v.dir]
+		r1, e1, dir1 := rule_IntegralGenzMalik(fun, c1, h1)
+		r2, e2, dir2 := rule_IntegralGenzMalik(fun, c2, h1)
+		neval += 2 * npt
+		sol += r1 + r2 - v.r
+		abserr += e1 + e2 - v.e
+		list[idx] = box_IntegralGenzMalik{c1, h1, r1, e1, dir1}
+		list = append(list, box_IntegralGenzMalik{c2, h1, r2, e2, dir2})
+	}
+	return sign * sol, abserr, neval, err
+}
+
+func fun95(x goNum.Matrix) float64 {
+	var sum float64
+	for _, v := range x.Data {
+		sum += (v - 0.3) * (v - 0.3)
+	}
+	return math.Exp(-10.0 * sum)
+}
+
+func BenchmarkIntegralGenzMalik(b *testing.B) {
+	a95 := goNum.ZeroMatrix(4, 1)
+	b95 := goNum.Slices1ToMatrix([]float64{1.0, 1.0, 1.0, 1.0})
+	for i := 0; i < b.N; i++ {
+		goNum.IntegralGenzMalik(fun95, a95, b95, 0.0, 1e-6, 1000000)
+	}
+}
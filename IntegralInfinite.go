@@ -0,0 +1,109 @@
+// IntegralInfinite
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    无穷区间积分的变量代换求积分公式
+理论：
+    以变量代换将无穷区间变为(0, 1]，再以IntegralGaussKronrod
+    全局自适应求积（Gauss-Kronrod节点不含端点t = 0）：
+    1. [a, +Inf)：x = a + (1-t)/t
+        +Inf        1
+        |f(x)dx = |f(a + (1-t)/t)/t^2 dt
+        a           0
+    2. (-Inf, b]：x = b - (1-t)/t
+         b          1
+        |f(x)dx = |f(b - (1-t)/t)/t^2 dt
+       -Inf         0
+    3. (-Inf, +Inf)：
+       +Inf         1
+        |f(x)dx = |(f((1-t)/t) + f(-(1-t)/t))/t^2 dt
+       -Inf         0
+    4. 有限区间直接调用IntegralGaussKronrod。
+    代换后t = 0附近的奇异性（f衰减较慢时）由IntegralGaussKronrod
+    的epsilon算法外推处理。
+
+    参考 R. Piessens, E. de Doncker-Kapenga, C.W. Uberhuber
+         and D.K. Kahaner. QUADPACK: A Subroutine Package for
+         Automatic Integration. Springer, 1983. ss 2.2.2 (QAGI).
+------------------------------------------------------
+输入   :
+    fun     被积分函数
+    a, b    积分区间，可为math.Inf(-1)、math.Inf(1)
+    key     1-G7-K15，2-G10-K21
+    epsabs  绝对误差上限
+    epsrel  相对误差上限
+    limit   子区间数上限
+输出   :
+    sol     解
+    abserr  误差估计
+    neval   函数值计算次数
+    err     解出标志：false-未解出或达到子区间数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+// IntegralInfinite 无穷区间积分的变量代换求积分公式
+func IntegralInfinite(fun func(float64) float64, a, b float64, key int,
+	epsabs, epsrel float64, limit int) (float64, float64, int, bool) {
+	/*
+		无穷区间积分的变量代换求积分公式
+		输入   :
+		    fun     被积分函数
+		    a, b    积分区间，可为math.Inf(-1)、math.Inf(1)
+		    key     1-G7-K15，2-G10-K21
+		    epsabs  绝对误差上限
+		    epsrel  相对误差上限
+		    limit   子区间数上限
+		输出   :
+		    sol     解
+		    abserr  误差估计
+		    neval   函数值计算次数
+		    err     解出标志：false-未解出或达到子区间数上限；
+		                     true-全部解出
+	*/
+	//判断区间
+	if math.IsNaN(a) || math.IsNaN(b) || (math.IsInf(a, 0) && (a == b)) {
+		panic("Error in goNum.IntegralInfinite: Interval error")
+	}
+
+	//上下限互换
+	sign := 1.0
+	if a > b {
+		a, b = b, a
+		sign = -1.0
+	}
+	var g func(float64) float64
+	switch {
+	case math.IsInf(a, -1) && math.IsInf(b, 1):
+		g = func(t float64) float64 {
+			x := (1.0 - t) / t
+			return (fun(x) + fun(-x)) / (t * t)
+		}
+	case math.IsInf(b, 1):
+		g = func(t float64) float64 {
+			return fun(a+(1.0-t)/t) / (t * t)
+		}
+	case math.IsInf(a, -1):
+		g = func(t float64) float64 {
+			return fun(b-(1.0-t)/t) / (t * t)
+		}
+	default:
+		sol, abserr, neval, err := IntegralGaussKronrod(fun, a, b, key, epsabs, epsrel, limit)
+		return sign * sol, abserr, neval, err
+	}
+	sol, abserr, neval, err := IntegralGaussKronrod(g, 0.0, 1.0, key, epsabs, epsrel, limit)
+	if math.IsInf(a, -1) && math.IsInf(b, 1) {
+		//每个t计算两次f
+		neval *= 2
+	}
+	return sign * sol, abserr, neval, err
+}
@@ -0,0 +1,331 @@
+// IntegralMonteCarlo_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    长方体区域上的Monte Carlo与随机化拟Monte Carlo求积分（并行）
+理论：
+    对于d重积分
+    b1   b2        bd
+    |dx1 |dx2 ... |f(x1, x2, ..., xd)dxd = V*E[f(X)]
+    a1   a2        ad
+    V为区域体积，X在区域内均匀分布。N个样本点分为reps组，每组
+    n = N/reps个点，第k组以随机数种子seed+k生成：
+    1. method = 0，Monte Carlo：伪随机均匀点，
+       I ~= V/N*Sum f(xi)，
+       标准误差s = V*sqrt(Var[f]/N)，Var[f]为全部N个样本的样本
+       方差。
+    2. method = 1，Sobol序列；method = 2，Halton序列：低差异
+       序列的前n个点uj经随机平移(Cranley-Patterson)
+       uj' = (uj + rk) mod 1，rk为第k组的均匀随机向量。各组估计
+       Ik无偏且相互独立，
+       I ~= (1/reps)Sum Ik，s = sqrt(Sum (Ik - I)^2/(reps(reps-1)))。
+       误差约O((ln N)^d/N)，光滑被积函数远优于Monte Carlo的
+       O(1/sqrt(N))。
+       Sobol序列以Gray码生成，方向数取Joe-Kuo表（d <= 21）；
+       Halton序列第i维取第i个素数为底的根式反演，d较大时各维
+       相关性增强。
+    各组按k mod workers分配至workers个goroutine，以Welford算法
+    累加，再按Chan并行合并公式汇总（见SDEMonteCarlo）。对同一
+    seed与reps，结果与workers及调度顺序无关。
+
+    参考 S. Joe and F.Y. Kuo. Constructing Sobol sequences
+         with better two-dimensional projections. SIAM J. Sci.
+         Comput., 2008, 30(5): 2635-2654.
+------------------------------------------------------
+输入   :
+    fun     被积分函数，自变量为dx1向量，须可并行调用
+    a, b    积分区间下限、上限，dx1向量
+    N       样本点总数
+    method  0-Monte Carlo，1-Sobol序列，2-Halton序列
+    reps    组数，method为1、2时reps >= 2
+    workers 并行goroutine数量
+    seed    初始随机数种子
+输出   :
+    sol     解
+    stderr  标准误差估计
+    err     解出标志：false-结果非有限值；true-解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+//Sobol序列方向数（Joe-Kuo new-joe-kuo-6.21201），第2维起：
+//本原多项式次数s、系数a与初始值m1, ..., ms
+var sobol_IntegralMonteCarlo = []struct {
+	s, a int
+	m    []uint32
+}{
+	{1, 0, []uint32{1}},
+	{2, 1, []uint32{1, 3}},
+	{3, 1, []uint32{1, 3, 1}},
+	{3, 2, []uint32{1, 1, 1}},
+	{4, 1, []uint32{1, 1, 3, 3}},
+	{4, 4, []uint32{1, 3, 5, 13}},
+	{5, 2, []uint32{1, 1, 5, 5, 17}},
+	{5, 4, []uint32{1, 1, 5, 5, 5}},
+	{5, 7, []uint32{1, 1, 7, 11, 19}},
+	{5, 11, []uint32{1, 1, 5, 1, 1}},
+	{5, 13, []uint32{1, 1, 1, 3, 11}},
+	{5, 14, []uint32{1, 3, 5, 5, 31}},
+	{6, 1, []uint32{1, 3, 3, 9, 7, 49}},
+	{6, 13, []uint32{1, 1, 1, 15, 21, 21}},
+	{6, 16, []uint32{1, 3, 1, 13, 27, 49}},
+	{6, 19, []uint32{1, 1, 1, 15, 7, 5}},
+	{6, 22, []uint32{1, 3, 1, 15, 13, 25}},
+	{6, 25, []uint32{1, 1, 5, 5, 19, 61}},
+	{7, 1, []uint32{1, 3, 7, 11, 23, 15, 103}},
+	{7, 4, []uint32{1, 3, 7, 13, 13, 15, 69}},
+}
+
+//d维Sobol序列方向数V[i][j]，j = 0, ..., 31
+func direction_IntegralMonteCarlo(d int) [][32]uint32 {
+	V := make([][32]uint32, d)
+	for j := 0; j < 32; j++ {
+		V[0][j] = 1 << uint(31-j)
+	}
+	for i := 1; i < d; i++ {
+		s := sobol_IntegralMonteCarlo[i-1].s
+		a := sobol_IntegralMonteCarlo[i-1].a
+		m := sobol_IntegralMonteCarlo[i-1].m
+		for j := 0; j < s; j++ {
+			V[i][j] = m[j] << uint(31-j)
+		}
+		for j := s; j < 32; j++ {
+			V[i][j] = V[i][j-s] ^ (V[i][j-s] >> uint(s))
+			for k := 1; k < s; k++ {
+				if (a>>uint(s-1-k))&1 == 1 {
+					V[i][j] ^= V[i][j-k]
+				}
+			}
+		}
+	}
+	return V
+}
+
+//前d个素数
+func primes_IntegralMonteCarlo(d int) []int {
+	sol := make([]int, 0, d)
+	for p := 2; len(sol) < d; p++ {
+		isPrime := true
+		for _, q := range sol {
+			if q*q > p {
+				break
+			}
+			if p%q == 0 {
+				isPrime = false
+				break
+			}
+		}
+		if isPrime {
+			sol = append(sol, p)
+		}
+	}
+	return sol
+}
+
+// IntegralMonteCarlo 长方体区域上的Monte Carlo与随机化拟Monte Carlo求积分（并行）
+func IntegralMonteCarlo(fun func(goNum.Matrix) float64, a, b goNum.Matrix, N, method, reps, workers int,
+	seed int64) (float64, float64, bool) {
+	/*
+		长方体区域上的Monte Carlo与随机化拟Monte Carlo求积分（并行）
+		输入   :
+		    fun     被积分函数，自变量为dx1向量，须可并行调用
+		    a, b    积分区间下限、上限，dx1向量
+		    N       样本点总数
+		    method  0-Monte Carlo，1-Sobol序列，2-Halton序列
+		    reps    组数，method为1、2时reps >= 2
+		    workers 并行goroutine数量
+		    seed    初始随机数种子
+		输出   :
+		    sol     解
+		    stderr  标准误差估计
+		    err     解出标志：false-结果非有限值；true-解出
+	*/
+	//判断区间
+	d := a.Rows
+	if (a.Columns != 1) || (b.Columns != 1) || (b.Rows != d) || (d < 1) {
+		panic("Error in goNum.IntegralMonteCarlo: a or b is not a dx1 vector")
+	}
+	for i := 0; i < d; i++ {
+		if math.IsInf(a.Data[i], 0) || math.IsInf(b.Data[i], 0) {
+			panic("Error in goNum.IntegralMonteCarlo: Interval is not finite")
+		}
+	}
+	//判断方法与维数
+	switch method {
+	case 0:
+	case 1:
+		if d > len(sobol_IntegralMonteCarlo)+1 {
+			panic("Error in goNum.IntegralMonteCarlo: Dimension is more than 21 for Sobol sequence")
+		}
+		if N/reps > 1<<31 {
+			panic("Error in goNum.IntegralMonteCarlo: N/reps is more than 2^31 for Sobol sequence")
+		}
+	case 2:
+	default:
+		panic("Error in goNum.IntegralMonteCarlo: method is not 0, 1 or 2")
+	}
+	//判断样本数、组数与goroutine数
+	if (reps < 1) || ((method != 0) && (reps < 2)) {
+		panic("Error in goNum.IntegralMonteCarlo: reps less than two")
+	}
+	n := N / reps
+	if (n < 1) || ((method == 0) && (n*reps < 2)) {
+		panic("Error in goNum.IntegralMonteCarlo: N is too small")
+	}
+	if workers < 1 {
+		panic("Error in goNum.IntegralMonteCarlo: workers less than one")
+	}
+	if workers > reps {
+		workers = reps
+	}
+
+	vol := 1.0
+	for i := 0; i < d; i++ {
+		vol *= b.Data[i] - a.Data[i]
+	}
+	var V [][32]uint32
+	var primes []int
+	switch method {
+	case 1:
+		V = direction_IntegralMonteCarlo(d)
+	case 2:
+		primes = primes_IntegralMonteCarlo(d)
+	}
+
+	//第k组的样本点均值与离差平方和（Welford）
+	group := func(k int) (float64, float64) {
+		r := rand.New(rand.NewSource(seed + int64(k)))
+		shift := make([]float64, d)
+		if method != 0 {
+			for i := range shift {
+				shift[i] = r.Float64()
+			}
+		}
+		x := goNum.ZeroMatrix(d, 1)
+		u := make([]float64, d)
+		sobol := make([]uint32, d)
+		var mean, m2 float64
+		for j := 0; j < n; j++ {
+			switch method {
+			case 0:
+				for i := range u {
+					u[i] = r.Float64()
+				}
+			case 1:
+				//Gray码：第j个点由第j-1个点异或V[c]得到，c为j-1最低位0的位置
+				if j > 0 {
+					c := 0
+					for (j-1)>>uint(c)&1 == 1 {
+						c++
+					}
+					for i := range sobol {
+						sobol[i] ^= V[i][c]
+					}
+				}
+				for i := range u {
+					u[i] = float64(sobol[i]) / 4294967296.0
+				}
+			case 2:
+				//根式反演，跳过第0个点
+				for i := range u {
+					p := primes[i]
+					var temp0 float64
+					f := 1.0 / float64(p)
+					for m := j + 1; m > 0; m /= p {
+						temp0 += float64(m%p) * f
+						f /= float64(p)
+					}
+					u[i] = temp0
+				}
+			}
+			for i := range u {
+				if method != 0 {
+					u[i] += shift[i]
+					if u[i] >= 1.0 {
+						u[i] -= 1.0
+					}
+				}
+				x.Data[i] = a.Data[i] + u[i]*(b.Data[i]-a.Data[i])
+			}
+			fx := fun(x)
+			delta := fx - mean
+			mean += delta / float64(j+1)
+			m2 += delta * (fx - mean)
+		}
+		return mean, m2
+	}
+
+	//各组结果
+	means := make([]float64, reps)
+	m2s := make([]float64, reps)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			for k := w; k < reps; k += workers {
+				means[k], m2s[k] = group(k)
+			}
+			wg.Done()
+		}(w)
+	}
+	wg.Wait()
+
+	//按组序号合并，与调度顺序无关
+	var err bool = false
+	mean, m2 := means[0], m2s[0]
+	var gmean, gm2 float64 //各组均值的均值与离差平方和
+	gmean = means[0]
+	cnt := float64(n)
+	for k := 1; k < reps; k++ {
+		delta := means[k] - mean
+		mean += delta * float64(n) / (cnt + float64(n))
+		m2 += m2s[k] + delta*delta*cnt*float64(n)/(cnt+float64(n))
+		cnt += float64(n)
+		gdelta := means[k] - gmean
+		gmean += gdelta / float64(k+1)
+		gm2 += gdelta * (means[k] - gmean)
+	}
+	sol := vol * mean
+	var stderr float64
+	if method == 0 {
+		stderr = math.Abs(vol) * math.Sqrt(m2/(cnt-1.0)/cnt)
+	} else {
+		stderr = math.Abs(vol) * math.Sqrt(gm2/float64(reps-1)/float64(reps))
+	}
+	if math.IsNaN(sol) || math.IsInf(sol, 0) {
+		return sol, stderr, err
+	}
+	err = true
+	return sol, stderr, err
+}
+
+func fun96(x goNum.Matrix) float64 {
+	var sum float64
+	for _, v := range x.Data {
+		sum += (v - 0.3) * (v - 0.3)
+	}
+	return math.Exp(-10.0 * sum)
+}
+
+func BenchmarkIntegralMonteCarlo(b *testing.B) {
+	a96 := goNum.ZeroMatrix(6, 1)
+	b96 := goNum.Slices1ToMatrix([]float64{1.0, 1.0, 1.0, 1.0, 1.0, 1.0})
+	for i := 0; i < b.N; i++ {
+		goNum.IntegralMonteCarlo(fun96, a96, b96, 1<<14, 0, 8, 4, 1)
+		goNum.IntegralMonteCarlo(fun96, a96, b96, 1<<14, 1, 8, 4, 1)
+		goNum.IntegralMonteCarlo(fun96, a96, b96, 1<<14, 2, 8, 4, 1)
+	}
+}
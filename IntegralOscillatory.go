@@ -0,0 +1,322 @@
+// IntegralOscillatory
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    振荡函数（Fourier型）积分的Filon型求积分公式
+理论：
+    对于积分
+    b                        b
+    |f(x)cos(wx)dx（key=1）或|f(x)sin(wx)dx（key=2）
+    a                        a
+    f光滑而w较大时，普通公式需在每个周期内取若干节点。Filon
+    型公式只对f插值，振荡因子精确积分（QAWO型）：
+
+    1. 子区间[c-h, c+h]上，以N+1个Chebyshev-Lobatto点
+       tj = cos(j*pi/N)对f(c+h*t)插值，
+       f(c+h*t) ~= Sum'' ak*Tk(t)，
+                 N
+       ak = (2/N)Sum'' f(c+h*tj)cos(j*k*pi/N)
+                 j=0
+       （''表示首末项减半），则
+       c+h                               N
+       |f(x)e^(iwx)dx ~= h*e^(iwc)*Sum'' ak*mu_k(wh)
+       c-h                               k=0
+       修正矩mu_k(s) = ∫T_k(t)e^(ist)dt（[-1, 1]）由Jacobi-Anger展开
+       e^(ist) = J0(s) + 2Sum i^j*Jj(s)Tj(t)得
+       mu_k(s) = Sum' 2i^j*Jj(s)*(v(k+j) + v(|k-j|))/2，
+       v(m) = 2/(1-m^2)（m为偶数），0（m为奇数），
+       Bessel函数Jj(s)以Miller向后递推求得，并以
+       J0 + 2Sum J_(2k) = 1归一化。对任意wh均稳定。
+    2. 取N = 24，以N = 12（隔点）的结果之差为误差估计，全局
+       自适应二分误差估计最大的子区间。
+    3. b = +Inf时（QAWF型），以周期长度L = (2[|w|]+1)pi/|w|将
+       [a, +Inf)分段，第k段以绝对误差上限epsabs*(1-p)p^k
+       （p = 0.9）求积，部分和序列以Wynn epsilon算法外推（见
+       IntegralGaussKronrod），至多50段。
+
+    参考 R. Piessens, E. de Doncker-Kapenga, C.W. Uberhuber
+         and D.K. Kahaner. QUADPACK: A Subroutine Package for
+         Automatic Integration. Springer, 1983. ss 2.2.3 (QAWO,
+         QAWF).
+------------------------------------------------------
+输入   :
+    fun     被积分函数中的非振荡部分f
+    a, b    积分区间，a有限，b可为math.Inf(1)（此时w != 0）
+    omega   角频率w
+    key     1-cos(wx)，2-sin(wx)
+    epsabs  绝对误差上限
+    epsrel  相对误差上限
+    limit   每段子区间数上限
+输出   :
+    sol     解
+    abserr  误差估计
+    neval   函数值计算次数
+    err     解出标志：false-未解出或达到子区间数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+//Bessel函数J0(s), ..., Jn(s)，Miller向后递推
+func besselJ_IntegralOscillatory(s float64, n int) []float64 {
+	sol := make([]float64, n+1)
+	if s == 0.0 {
+		sol[0] = 1.0
+		return sol
+	}
+	as := math.Abs(s)
+	//起始阶数须大于s
+	m := int(math.Max(float64(n), as))
+	m += 20 + int(math.Sqrt(160.0*float64(m)))
+	m += m % 2
+	bjp, bj := 0.0, 1.0
+	var sum float64
+	for j := m; j > 0; j-- {
+		bjm := 2.0*float64(j)/as*bj - bjp
+		bjp, bj = bj, bjm
+		if math.Abs(bj) > 1e250 {
+			//防止溢出
+			bj *= 1e-250
+			bjp *= 1e-250
+			sum *= 1e-250
+			for k := j; k < n+1; k++ {
+				sol[k] *= 1e-250
+			}
+		}
+		if j-1 < n+1 {
+			sol[j-1] = bj
+		}
+		if (j-1)%2 == 0 && (j-1 > 0) {
+			sum += 2.0 * bj
+		}
+	}
+	sum += bj
+	for k := range sol {
+		sol[k] /= sum
+		//J_k(-s) = (-1)^k*J_k(s)
+		if (s < 0.0) && (k%2 == 1) {
+			sol[k] = -sol[k]
+		}
+	}
+	return sol
+}
+
+//[c-h, c+h]上f(x)e^(iwx)积分的Filon-Clenshaw-Curtis公式，
+//返回key对应分量的积分值与误差估计
+func rule_IntegralOscillatory(fun func(float64) float64, a, b, omega float64, key int) (float64, float64) {
+	const N = 24
+	c := (a + b) / 2.0
+	h := (b - a) / 2.0
+	s := omega * h
+	fv := make([]float64, N+1)
+	for j := 0; j < N+1; j++ {
+		fv[j] = fun(c + h*math.Cos(float64(j)*math.Pi/float64(N)))
+	}
+	//Chebyshev系数（含首末项减半），n = N或N/2
+	cheb := func(n int) []float64 {
+		step := N / n
+		ak := make([]float64, n+1)
+		for k := 0; k < n+1; k++ {
+			var temp0 float64
+			for j := 0; j < n+1; j++ {
+				temp1 := fv[j*step] * math.Cos(float64(j*k)*math.Pi/float64(n))
+				if (j == 0) || (j == n) {
+					temp1 /= 2.0
+				}
+				temp0 += temp1
+			}
+			ak[k] = 2.0 * temp0 / float64(n)
+			if (k == 0) || (k == n) {
+				ak[k] /= 2.0
+			}
+		}
+		return ak
+	}
+	//修正矩mu_k，k偶数时为实数，奇数时为纯虚数
+	v := func(m int) float64 {
+		if m%2 == 1 {
+			return 0.0
+		}
+		return 2.0 / (1.0 - float64(m*m))
+	}
+	as := math.Abs(s)
+	jmax := N + int(as+20.0+10.0*math.Cbrt(as))
+	J := besselJ_IntegralOscillatory(s, jmax)
+	mu := make([]complex128, N+1)
+	for k := 0; k < N+1; k++ {
+		var temp0 float64
+		for j := k % 2; j < jmax+1; j += 2 {
+			temp1 := J[j] * (v(k+j) + v(int(math.Abs(float64(k-j)))))
+			switch {
+			case j == 0:
+				temp1 /= 2.0
+			case (j%4 == 2) || (j%4 == 3):
+				//i^j
+				temp1 = -temp1
+			}
+			temp0 += temp1
+		}
+		if k%2 == 0 {
+			mu[k] = complex(temp0, 0)
+		} else {
+			mu[k] = complex(0, temp0)
+		}
+	}
+	sum := func(ak []float64) complex128 {
+		var sol complex128
+		for k := range ak {
+			sol += complex(ak[k], 0) * mu[k]
+		}
+		return complex(h*math.Cos(omega*c), h*math.Sin(omega*c)) * sol
+	}
+	r24 := sum(cheb(N))
+	r12 := sum(cheb(N / 2))
+	res, res12 := real(r24), real(r12)
+	if key == 2 {
+		res, res12 = imag(r24), imag(r12)
+	}
+	e := math.Max(math.Abs(res-res12), 50.0*2.220446049250313e-16*math.Abs(res))
+	return res, e
+}
+
+//有限区间[a, b]上的全局自适应Filon型公式
+func finite_IntegralOscillatory(fun func(float64) float64, a, b, omega float64, key int,
+	epsabs, epsrel float64, limit int) (float64, float64, int, bool) {
+	var err bool = false
+	neval := 25
+	r0, e0 := rule_IntegralOscillatory(fun, a, b, omega, key)
+	list := []interval_IntegralGaussKronrod{{a, b, r0, e0, 0}}
+	sol, abserr := r0, e0
+	for {
+		//解出
+		if abserr <= math.Max(epsabs, epsrel*math.Abs(sol)) {
+			err = true
+			return sol, abserr, neval, err
+		}
+		if len(list) >= limit {
+			break
+		}
+		idx := 0
+		for i, v := range list {
+			if v.e > list[idx].e {
+				idx = i
+			}
+		}
+		//二分
+		v := list[idx]
+		m := (v.a + v.b) / 2.0
+		if (m <= math.Min(v.a, v.b)) || (m >= math.Max(v.a, v.b)) {
+			//子区间过小，达到机器精度
+			break
+		}
+		r1, e1 := rule_IntegralOscillatory(fun, v.a, m, omega, key)
+		r2, e2 := rule_IntegralOscillatory(fun, m, v.b, omega, key)
+		neval += 50
+		sol += r1 + r2 - v.r
+		abserr += e1 + e2 - v.e
+		list[idx] = interval_IntegralGaussKronrod{v.a, m, r1, e1, v.level + 1}
+		list = append(list, interval_IntegralGaussKronrod{m, v.b, r2, e2, v.level + 1})
+	}
+	return sol, abserr, neval, err
+}
+
+// IntegralOscillatory 振荡函数（Fourier型）积分的Filon型求积分公式
+func IntegralOscillatory(fun func(float64) float64, a, b, omega float64, key int,
+	epsabs, epsrel float64, limit int) (float64, float64, int, bool) {
+	/*
+		振荡函数（Fourier型）积分的Filon型求积分公式
+		输入   :
+		    fun     被积分函数中的非振荡部分f
+		    a, b    积分区间，a有限，b可为math.Inf(1)（此时w != 0）
+		    omega   角频率w
+		    key     1-cos(wx)，2-sin(wx)
+		    epsabs  绝对误差上限
+		    epsrel  相对误差上限
+		    limit   每段子区间数上限
+		输出   :
+		    sol     解
+		    abserr  误差估计
+		    neval   函数值计算次数
+		    err     解出标志：false-未解出或达到子区间数上限；
+		                     true-全部解出
+	*/
+	//判断key
+	if (key != 1) && (key != 2) {
+		panic("Error in goNum.IntegralOscillatory: key is not 1 or 2")
+	}
+	//判断区间
+	if math.IsInf(a, 0) || math.IsNaN(a) || math.IsNaN(b) || math.IsInf(b, -1) {
+		panic("Error in goNum.IntegralOscillatory: Interval error")
+	}
+	if math.IsInf(b, 1) && (omega == 0.0) {
+		panic("Error in goNum.IntegralOscillatory: omega is zero on infinite interval")
+	}
+	//判断误差与子区间数
+	if (epsabs < 0.0) || (epsrel < 0.0) || ((epsabs == 0.0) && (epsrel == 0.0)) || (limit < 1) {
+		panic("Error in goNum.IntegralOscillatory: Tolerance or limit error")
+	}
+
+	if !math.IsInf(b, 1) {
+		return finite_IntegralOscillatory(fun, a, b, omega, key, epsabs, epsrel, limit)
+	}
+
+	//[a, +Inf)，分段求和并外推
+	var err bool = false
+	p := 0.9
+	L := (2.0*math.Floor(math.Abs(omega)) + 1.0) * math.Pi / math.Abs(omega)
+	neval := 0
+	var sol, errsum float64
+	seq := make([]float64, 0)
+	var res3 []float64
+	resext, errext := 0.0, math.Inf(1)
+	epsk := epsabs * (1.0 - p)
+	for k := 0; k < 50; k++ {
+		x0 := a + float64(k)*L
+		r, e, n, _ := finite_IntegralOscillatory(fun, x0, x0+L, omega, key, epsk, epsrel, limit)
+		epsk *= p
+		neval += n
+		sol += r
+		errsum += e
+		tol := math.Max(epsabs, epsrel*math.Abs(sol))
+		//直接求和已收敛（以末段值估计截断误差）
+		if (k > 0) && (errsum+math.Abs(r) <= tol) {
+			err = true
+			return sol, errsum + math.Abs(r), neval, err
+		}
+		seq = append(seq, sol)
+		if len(seq) > 25 {
+			seq = seq[1:]
+		}
+		if len(seq) < 3 {
+			continue
+		}
+		temp0 := epsilon_IntegralGaussKronrod(seq)
+		res3 = append(res3, temp0)
+		if len(res3) > 3 {
+			res3 = res3[1:]
+		}
+		if len(res3) == 3 {
+			e := math.Abs(temp0-res3[0]) + math.Abs(temp0-res3[1]) + errsum
+			e = math.Max(e, 5.0*2.220446049250313e-16*math.Abs(temp0))
+			if e < errext {
+				resext, errext = temp0, e
+			}
+			if errext <= tol {
+				err = true
+				return resext, errext, neval, err
+			}
+		}
+	}
+	if errext < math.Inf(1) {
+		return resext, errext, neval, err
+	}
+	return sol, errsum, neval, err
+}
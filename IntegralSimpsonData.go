@@ -0,0 +1,100 @@
+// IntegralSimpsonData
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    离散数据（可非等距）的复化Simpson求积分公式
+理论：
+    对于N个数据点(xi, yi)，x0 < x1 < ... < x(N-1)，n = N-1个区间。
+    过相邻三点(x0, y0)、(x1, y1)、(x2, y2)的抛物线在两个区间上的
+    积分（h0 = x1 - x0，h1 = x2 - x1，H = h0 + h1）：
+    x1      h0  2h0+3h1      h0+3h1      h0^2
+    |p dx = --(-------y0 + ------y1 - -----y2)
+    x0      6     H            h1       H*h1
+    x2      h1  2h1+3h0      h1+3h0      h1^2
+    |p dx = --(-------y2 + ------y1 - -----y0)
+    x1      6     H            h0       H*h0
+    等距时两式之和即Simpson公式h/3*(y0 + 4y1 + y2)。
+    1. n为偶数：逐对区间[x(2k), x(2k+2)]求和；
+    2. n为奇数(n >= 3)：前n-1个区间同上，最后一个区间取过
+       末三点的抛物线在[x(n-1), xn]上的积分；
+    3. n = 1：梯形公式。
+    误差O(h^4)。
+------------------------------------------------------
+输入   :
+    xy      单自变量单因变量的N个数据对，Nx2，第一列x严格递增，
+            N >= 2
+输出   :
+    sol     解
+    err     解出标志：false-未解出；true-解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+//过(0, y0)、(h0, y1)、(h0+h1, y2)的抛物线在[0, h0]与[h0, h0+h1]上的积分
+func parabola_IntegralSimpsonData(h0, h1, y0, y1, y2 float64) (float64, float64) {
+	H := h0 + h1
+	I0 := h0 / 6.0 * ((2.0*h0+3.0*h1)/H*y0 + (h0+3.0*h1)/h1*y1 - h0*h0/(H*h1)*y2)
+	I1 := h1 / 6.0 * ((2.0*h1+3.0*h0)/H*y2 + (h1+3.0*h0)/h0*y1 - h1*h1/(H*h0)*y0)
+	return I0, I1
+}
+
+//各区间上的积分，区间[x(i-1), xi]对应第i-1个元素
+func intervals_IntegralSimpsonData(xy Matrix) []float64 {
+	N := xy.Rows
+	n := N - 1
+	sol := make([]float64, n)
+	if n == 1 {
+		sol[0] = (xy.GetFromMatrix(1, 0) - xy.GetFromMatrix(0, 0)) *
+			(xy.GetFromMatrix(0, 1) + xy.GetFromMatrix(1, 1)) / 2.0
+		return sol
+	}
+	//三点抛物线，i为中间点
+	parabola := func(i int) (float64, float64) {
+		h0 := xy.GetFromMatrix(i, 0) - xy.GetFromMatrix(i-1, 0)
+		h1 := xy.GetFromMatrix(i+1, 0) - xy.GetFromMatrix(i, 0)
+		return parabola_IntegralSimpsonData(h0, h1,
+			xy.GetFromMatrix(i-1, 1), xy.GetFromMatrix(i, 1), xy.GetFromMatrix(i+1, 1))
+	}
+	for k := 0; k+2 < N; k += 2 {
+		sol[k], sol[k+1] = parabola(k + 1)
+	}
+	if n%2 == 1 {
+		//奇数个区间，最后一个区间
+		_, sol[n-1] = parabola(n - 1)
+	}
+	return sol
+}
+
+// IntegralSimpsonData 离散数据（可非等距）的复化Simpson求积分公式
+func IntegralSimpsonData(xy Matrix) (float64, bool) {
+	/*
+		离散数据（可非等距）的复化Simpson求积分公式
+		输入   :
+		    xy      单自变量单因变量的N个数据对，Nx2，第一列x严格递增，
+		            N >= 2
+		输出   :
+		    sol     解
+		    err     解出标志：false-未解出；true-解出
+	*/
+	check_IntegralTrapezoidData(xy, "IntegralSimpsonData")
+
+	var err bool = false
+	var sol float64
+	for _, v := range intervals_IntegralSimpsonData(xy) {
+		sol += v
+	}
+
+	if math.IsNaN(sol) || math.IsInf(sol, 0) {
+		return sol, err
+	}
+	err = true
+	return sol, err
+}
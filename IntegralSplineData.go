@@ -0,0 +1,126 @@
+// IntegralSplineData
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    离散数据（可非等距）的三次样条求积分公式
+理论：
+    对于N个数据点(xi, yi)，x0 < x1 < ... < x(N-1)，n = N-1个区间，
+    以三次样条S(x)插值，Mi = S''(xi)，hi = xi - x(i-1)，
+    di = (yi - y(i-1))/hi，则(i = 1, ..., n-1)
+    hi*M(i-1) + 2(hi+h(i+1))Mi + h(i+1)M(i+1) = 6(d(i+1) - di)
+    边界取not-a-knot条件（x1、x(n-1)处三阶导数连续）：
+    M0 = ((h1+h2)M1 - h1*M2)/h2
+    Mn = ((h(n-1)+hn)M(n-1) - hn*M(n-2))/h(n-1)
+    代入后得M1, ..., M(n-1)的三对角方程组，以追赶法求解。
+    各区间上的积分
+    xi            hi                hi^3
+    |S(x)dx = ---(y(i-1) + yi) - ----(M(i-1) + Mi)
+    x(i-1)        2                 24
+    not-a-knot样条对三次多项式精确，误差O(h^4)。
+    n = 2时样条即过三点的抛物线（IntegralSimpsonData），n = 1
+    时为梯形公式。
+
+    与InterpSpline11等相比，无需给出端点导数，且不生成稠密
+    矩阵，适用于大量测量数据。
+------------------------------------------------------
+输入   :
+    xy      单自变量单因变量的N个数据对，Nx2，第一列x严格递增，
+            N >= 2
+输出   :
+    sol     解
+    err     解出标志：false-未解出；true-解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+//not-a-knot三次样条在各节点处的二阶导数M0, ..., Mn，n >= 3
+func moments_IntegralSplineData(xy Matrix) []float64 {
+	n := xy.Rows - 1
+	h := make([]float64, n+1) //h[i] = xi - x(i-1)
+	d := make([]float64, n+1)
+	for i := 1; i < n+1; i++ {
+		h[i] = xy.GetFromMatrix(i, 0) - xy.GetFromMatrix(i-1, 0)
+		d[i] = (xy.GetFromMatrix(i, 1) - xy.GetFromMatrix(i-1, 1)) / h[i]
+	}
+	//三对角方程组，第i-1行对应Mi
+	m := n - 1
+	lo := make([]float64, m)
+	di := make([]float64, m)
+	up := make([]float64, m)
+	rhs := make([]float64, m)
+	for i := 1; i < n; i++ {
+		lo[i-1] = h[i]
+		di[i-1] = 2.0 * (h[i] + h[i+1])
+		up[i-1] = h[i+1]
+		rhs[i-1] = 6.0 * (d[i+1] - d[i])
+	}
+	//not-a-knot边界
+	di[0] += h[1] * (h[1] + h[2]) / h[2]
+	up[0] -= h[1] * h[1] / h[2]
+	di[m-1] += h[n] * (h[n-1] + h[n]) / h[n-1]
+	lo[m-1] -= h[n] * h[n] / h[n-1]
+	//追赶法
+	for i := 1; i < m; i++ {
+		w := lo[i] / di[i-1]
+		di[i] -= w * up[i-1]
+		rhs[i] -= w * rhs[i-1]
+	}
+	M := make([]float64, n+1)
+	M[m] = rhs[m-1] / di[m-1]
+	for i := m - 2; i >= 0; i-- {
+		M[i+1] = (rhs[i] - up[i]*M[i+2]) / di[i]
+	}
+	M[0] = ((h[1]+h[2])*M[1] - h[1]*M[2]) / h[2]
+	M[n] = ((h[n-1]+h[n])*M[n-1] - h[n]*M[n-2]) / h[n-1]
+	return M
+}
+
+//各区间上的积分，区间[x(i-1), xi]对应第i-1个元素
+func intervals_IntegralSplineData(xy Matrix) []float64 {
+	n := xy.Rows - 1
+	if n < 3 {
+		return intervals_IntegralSimpsonData(xy)
+	}
+	M := moments_IntegralSplineData(xy)
+	sol := make([]float64, n)
+	for i := 1; i < n+1; i++ {
+		hi := xy.GetFromMatrix(i, 0) - xy.GetFromMatrix(i-1, 0)
+		sol[i-1] = hi*(xy.GetFromMatrix(i-1, 1)+xy.GetFromMatrix(i, 1))/2.0 -
+			hi*hi*hi*(M[i-1]+M[i])/24.0
+	}
+	return sol
+}
+
+// IntegralSplineData 离散数据（可非等距）的三次样条求积分公式
+func IntegralSplineData(xy Matrix) (float64, bool) {
+	/*
+		离散数据（可非等距）的三次样条求积分公式
+		输入   :
+		    xy      单自变量单因变量的N个数据对，Nx2，第一列x严格递增，
+		            N >= 2
+		输出   :
+		    sol     解
+		    err     解出标志：false-未解出；true-解出
+	*/
+	check_IntegralTrapezoidData(xy, "IntegralSplineData")
+
+	var err bool = false
+	var sol float64
+	for _, v := range intervals_IntegralSplineData(xy) {
+		sol += v
+	}
+
+	if math.IsNaN(sol) || math.IsInf(sol, 0) {
+		return sol, err
+	}
+	err = true
+	return sol, err
+}
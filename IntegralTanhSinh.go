@@ -0,0 +1,201 @@
+// IntegralTanhSinh
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    tanh-sinh（双指数）求积分公式
+理论：
+    对于积分
+    b
+    |f(x)dx
+    a
+
+    变量代换x = c + h*tanh(pi/2*sinh(t))，c = (a+b)/2，h = (b-a)/2，
+    b            +Inf
+    |f(x)dx = h*|f(x(t))w(t)dt，w(t) = (pi/2)cosh(t)/cosh^2(pi/2*sinh(t))
+    a           -Inf
+    w(t)随|t|双指数衰减，对t以步长s = 2^(-k)作梯形公式求积：
+    I_k = h*s*Sum f(x(js))w(js)
+    第k层只需计算奇数j的新节点。被积函数在端点的奇异性（如
+    x^(-0.9)、ln(x)）被w(t)的双指数衰减抵消，一般每加一层有效
+    数字约加倍。
+    为避免端点附近的相减抵消，以d = 1 - tanh(u) = e^(-u)/cosh(u)
+    计算端点距离：x = b - h*d或x = a + h*d。x舍入为端点或f(x)
+    非有限时，该侧求和结束。
+    误差估计取|I_k - I_(k-1)|。
+
+    IntegralTanhSinhC的被积函数另以xc传入x到较近端点的有符号
+    距离（同Boost tanh_sinh）：x <= c时xc = a - x <= 0，x > c
+    时xc = b - x > 0。xc由h*d直接得到，不受x舍入影响，端点处
+    奇异因子（如1 - x）可由xc精确计算；x舍入为端点时仍继续求和。
+
+    参考 H. Takahasi and M. Mori. Double exponential formulas
+         for numerical integration. Publ. RIMS, Kyoto Univ.,
+         1974, 9: 721-741.
+------------------------------------------------------
+注意事项：
+    IntegralTanhSinh的fun只以x为自变量，端点附近x的分辨率受浮点
+    数间距限制（x = 1附近约1e-16），非零端点处的奇异性（如
+    1/sqrt(1-x^2)在x = 1）精度受限，宜先平移使奇点位于x = 0，
+    或使用IntegralTanhSinhC以xc计算奇异因子。
+------------------------------------------------------
+输入   :
+    fun     被积分函数，IntegralTanhSinhC为fun(x, xc)
+    a, b    积分区间，有限
+    epsabs  绝对误差上限
+    epsrel  相对误差上限
+    maxlevel    最大加密层数
+输出   :
+    sol     解
+    abserr  误差估计
+    neval   函数值计算次数
+    err     解出标志：false-未解出或达到最大加密层数；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+//tanh-sinh求积，fun(x, xc)，usexc为false时fun不使用xc，name为调用函数名
+func core_IntegralTanhSinh(fun func(float64, float64) float64, a, b float64,
+	epsabs, epsrel float64, maxlevel int, usexc bool, name string) (float64, float64, int, bool) {
+	//判断区间
+	if math.IsInf(a, 0) || math.IsInf(b, 0) || math.IsNaN(a) || math.IsNaN(b) {
+		panic("Error in goNum." + name + ": Interval is not finite")
+	}
+	//判断误差与层数
+	if (epsabs < 0.0) || (epsrel < 0.0) || ((epsabs == 0.0) && (epsrel == 0.0)) || (maxlevel < 1) {
+		panic("Error in goNum." + name + ": Tolerance or maxlevel error")
+	}
+
+	var err bool = false
+	if a == b {
+		err = true
+		return 0.0, 0.0, 0, err
+	}
+	h := (b - a) / 2.0
+	neval := 0
+	//t处左右两节点的f*w之和，end为true时该侧求和结束；
+	//usexc为false时x舍入为端点即结束
+	var endl, endr bool
+	term := func(t float64) float64 {
+		u := math.Pi / 2.0 * math.Sinh(t)
+		cu := math.Cosh(u)
+		w := math.Pi / 2.0 * math.Cosh(t) / (cu * cu)
+		d := math.Exp(-u) / cu
+		xc := h * d
+		var sol float64
+		if !endr {
+			x := b - xc
+			stop := (xc == 0.0) || ((x == b) && !usexc)
+			fx := 0.0
+			if !stop {
+				fx = fun(x, xc)
+				neval++
+			}
+			if stop || math.IsNaN(fx) || math.IsInf(fx, 0) || (w == 0.0) {
+				endr = true
+			} else {
+				sol += fx * w
+			}
+		}
+		if !endl {
+			x := a + xc
+			stop := (xc == 0.0) || ((x == a) && !usexc)
+			fx := 0.0
+			if !stop {
+				fx = fun(x, -xc)
+				neval++
+			}
+			if stop || math.IsNaN(fx) || math.IsInf(fx, 0) || (w == 0.0) {
+				endl = true
+			} else {
+				sol += fx * w
+			}
+		}
+		return sol
+	}
+
+	//第0层，s = 1
+	tmax := 6.5 //w(6.5)下溢
+	sum := math.Pi / 2.0 * fun((a+b)/2.0, -h)
+	neval++
+	for j := 1; float64(j) <= tmax; j++ {
+		sum += term(float64(j))
+		if endl && endr {
+			break
+		}
+	}
+	sol := h * sum
+	abserr := math.Inf(1)
+	s := 1.0
+	for k := 1; k < maxlevel+1; k++ {
+		s /= 2.0
+		endl, endr = false, false
+		for t := s; t <= tmax; t += 2.0 * s {
+			sum += term(t)
+			if endl && endr {
+				break
+			}
+		}
+		temp0 := h * s * sum
+		abserr = math.Abs(temp0 - sol)
+		sol = temp0
+		//解出
+		if abserr <= math.Max(epsabs, epsrel*math.Abs(sol)) {
+			err = true
+			return sol, abserr, neval, err
+		}
+	}
+	return sol, abserr, neval, err
+}
+
+// IntegralTanhSinh tanh-sinh（双指数）求积分公式
+func IntegralTanhSinh(fun func(float64) float64, a, b float64,
+	epsabs, epsrel float64, maxlevel int) (float64, float64, int, bool) {
+	/*
+		tanh-sinh（双指数）求积分公式
+		输入   :
+		    fun     被积分函数
+		    a, b    积分区间，有限
+		    epsabs  绝对误差上限
+		    epsrel  相对误差上限
+		    maxlevel    最大加密层数
+		输出   :
+		    sol     解
+		    abserr  误差估计
+		    neval   函数值计算次数
+		    err     解出标志：false-未解出或达到最大加密层数；
+		                     true-全部解出
+	*/
+	return core_IntegralTanhSinh(func(x, xc float64) float64 { return fun(x) },
+		a, b, epsabs, epsrel, maxlevel, false, "IntegralTanhSinh")
+}
+
+// IntegralTanhSinhC tanh-sinh（双指数）求积分公式，被积函数另以端点距离xc为自变量
+func IntegralTanhSinhC(fun func(float64, float64) float64, a, b float64,
+	epsabs, epsrel float64, maxlevel int) (float64, float64, int, bool) {
+	/*
+		tanh-sinh（双指数）求积分公式，被积函数另以端点距离xc为自变量
+		输入   :
+		    fun     被积分函数fun(x, xc)，xc为x到较近端点的有符号
+		            距离：x <= (a+b)/2时xc = a - x，否则xc = b - x
+		    a, b    积分区间，有限
+		    epsabs  绝对误差上限
+		    epsrel  相对误差上限
+		    maxlevel    最大加密层数
+		输出   :
+		    sol     解
+		    abserr  误差估计
+		    neval   函数值计算次数
+		    err     解出标志：false-未解出或达到最大加密层数；
+		                     true-全部解出
+	*/
+	return core_IntegralTanhSinh(fun, a, b, epsabs, epsrel, maxlevel, true, "IntegralTanhSinhC")
+}
@@ -0,0 +1,68 @@
+// IntegralTrapezoidData
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    离散数据（可非等距）的复化梯形求积分公式
+理论：
+    对于N个数据点(xi, yi)，x0 < x1 < ... < x(N-1)，
+    x(N-1)          N-1  hi
+    |y(x)dx   ~=  Sum  --(y(i-1) + yi)，hi = xi - x(i-1)
+    x0              i=1  2
+    误差O(h^2)。
+------------------------------------------------------
+输入   :
+    xy      单自变量单因变量的N个数据对，Nx2，第一列x严格递增，
+            N >= 2
+输出   :
+    sol     解
+    err     解出标志：false-未解出；true-解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+//判断数据矩阵，name为调用函数名
+func check_IntegralTrapezoidData(xy Matrix, name string) {
+	if (xy.Columns != 2) || (xy.Rows < 2) {
+		panic("Error in goNum." + name + ": xy is not a Nx2 matrix with N >= 2")
+	}
+	for i := 1; i < xy.Rows; i++ {
+		if !(xy.Data[2*i] > xy.Data[2*(i-1)]) {
+			panic("Error in goNum." + name + ": x is not strictly increasing")
+		}
+	}
+}
+
+// IntegralTrapezoidData 离散数据（可非等距）的复化梯形求积分公式
+func IntegralTrapezoidData(xy Matrix) (float64, bool) {
+	/*
+		离散数据（可非等距）的复化梯形求积分公式
+		输入   :
+		    xy      单自变量单因变量的N个数据对，Nx2，第一列x严格递增，
+		            N >= 2
+		输出   :
+		    sol     解
+		    err     解出标志：false-未解出；true-解出
+	*/
+	check_IntegralTrapezoidData(xy, "IntegralTrapezoidData")
+
+	var err bool = false
+	var sol float64
+	for i := 1; i < xy.Rows; i++ {
+		hi := xy.GetFromMatrix(i, 0) - xy.GetFromMatrix(i-1, 0)
+		sol += hi * (xy.GetFromMatrix(i-1, 1) + xy.GetFromMatrix(i, 1)) / 2.0
+	}
+
+	if math.IsNaN(sol) || math.IsInf(sol, 0) {
+		return sol, err
+	}
+	err = true
+	return sol, err
+}
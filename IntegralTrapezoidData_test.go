@@ -0,0 +1,92 @@
+// IntegralTrapezoidData_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    离散数据（可非等距）的复化梯形求积分公式
+理论：
+    对于N个数据点(xi, yi)，x0 < x1 < ... < x(N-1)，
+    x(N-1)          N-1  hi
+    |y(x)dx   ~=  Sum  --(y(i-1) + yi)，hi = xi - x(i-1)
+    x0              i=1  2
+    误差O(h^2)。
+------------------------------------------------------
+输入   :
+    xy      单自变量单因变量的N个数据对，Nx2，第一列x严格递增，
+            N >= 2
+输出   :
+    sol     解
+    err     解出标志：false-未解出；true-解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+//判断数据矩阵，name为调用函数名
+func check_IntegralTrapezoidData(xy goNum.Matrix, name string) {
+	if (xy.Columns != 2) || (xy.Rows < 2) {
+		panic("Error in goNum." + name + ": xy is not a Nx2 matrix with N >= 2")
+	}
+	for i := 1; i < xy.Rows; i++ {
+		if !(xy.Data[2*i] > xy.Data[2*(i-1)]) {
+			panic("Error in goNum." + name + ": x is not strictly increasing")
+		}
+	}
+}
+
+// IntegralTrapezoidData 离散数据（可非等距）的复化梯形求积分公式
+func IntegralTrapezoidData(xy goNum.Matrix) (float64, bool) {
+	/*
+		离散数据（可非等距）的复化梯形求积分公式
+		输入   :
+		    xy      单自变量单因变量的N个数据对，Nx2，第一列x严格递增，
+		            N >= 2
+		输出   :
+		    sol     解
+		    err     解出标志：false-未解出；true-解出
+	*/
+	check_IntegralTrapezoidData(xy, "IntegralTrapezoidData")
+
+	var err bool = false
+	var sol float64
+	for i := 1; i < xy.Rows; i++ {
+		hi := xy.GetFromMatrix(i, 0) - xy.GetFromMatrix(i-1, 0)
+		sol += hi * (xy.GetFromMatrix(i-1, 1) + xy.GetFromMatrix(i, 1)) / 2.0
+	}
+
+	if math.IsNaN(sol) || math.IsInf(sol, 0) {
+		return sol, err
+	}
+	err = true
+	return sol, err
+}
+
+//非等距网格上的sin(x)采样数据，x∈[0, pi]，N个点
+//非等距采样的sin(x)，[0, pi]上N个点，亦用于IntegralSimpsonData、
+//IntegralSplineData、IntegralCumulativeData
+func fun97(N int) goNum.Matrix {
+	sol := goNum.ZeroMatrix(N, 2)
+	for i := 0; i < N; i++ {
+		u := float64(i) / float64(N-1)
+		x := math.Pi * (u + 0.1*math.Sin(math.Pi*u))
+		sol.SetMatrix(i, 0, x)
+		sol.SetMatrix(i, 1, math.Sin(x))
+	}
+	return sol
+}
+
+func BenchmarkIntegralTrapezoidData(b *testing.B) {
+	xy97 := fun97(1001)
+	for i := 0; i < b.N; i++ {
+		goNum.IntegralTrapezoidData(xy97)
+	}
+}
@@ -0,0 +1,141 @@
+// NLEs_Broyden
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    多元非线性方程组的Broyden拟Newton法（含线搜索）
+理论：
+    F(x) = 0，以Bk近似Jacobi矩阵，B0 = J(x0)，J为nil时由
+    NLEs_JacobianFD差分近似：
+    Bk*dk = -F(xk)
+    x_(k+1) = xk + a*dk，a由Armijo回溯线搜索确定（同NLEs_Newton）
+    sk = x_(k+1) - xk，yk = F(x_(k+1)) - F(xk)，Broyden秩一修正：
+                      (yk - Bk*sk)sk'
+    B_(k+1) = Bk + -----------------
+                         sk'sk
+    线搜索失败（dk非下降方向）或Bk奇异时重新计算Bk = J(xk)；J(xk)
+    奇异时取最速下降方向dk = -J(xk)'F(xk)作线搜索。
+    每步只需计算一次F，接近解时超线性收敛。
+
+    参考 C.G. Broyden. A class of methods for solving
+         nonlinear simultaneous equations. Math. Comp., 1965,
+         19(92): 577-593.
+------------------------------------------------------
+输入   :
+    funs    方程组，nx1
+    J       Jacobi矩阵，nxn，为nil时差分近似
+    x0      初值x
+    tol     控制误差
+    n       最大迭代次数
+输出   :
+    sol     解，nx1
+    err     解出标志：false-未解出或达到边界；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+// NLEs_Broyden 多元非线性方程组的Broyden拟Newton法（含线搜索）
+func NLEs_Broyden(funs, J func(Matrix) Matrix, x0 Matrix, tol float64, n int) (Matrix, bool) {
+	/*
+		多元非线性方程组的Broyden拟Newton法（含线搜索）
+		输入   :
+		    funs    方程组，nx1
+		    J       Jacobi矩阵，nxn，为nil时差分近似
+		    x0      初值x
+		    tol     控制误差
+		    n       最大迭代次数
+		输出   :
+		    sol     解，nx1
+		    err     解出标志：false-未解出或达到边界；
+		                     true-全部解出
+	*/
+	//判断x维数
+	if x0.Columns != 1 {
+		panic("Error in goNum.NLEs_Broyden: x0 is not a vector")
+	}
+	if J == nil {
+		J = func(x Matrix) Matrix { return NLEs_JacobianFD(funs, x) }
+	}
+
+	var err bool = false
+	nx := x0.Rows
+	sol := ZeroMatrix(nx, 1)
+	copy(sol.Data, x0.Data)
+	F := funs(sol)
+	B := J(sol)
+	fresh := true //B是否为当前点的Jacobi矩阵
+	for k := 0; k < n; k++ {
+		maxy, _, _ := MaxAbs(F.Data)
+		if math.Abs(maxy) < tol {
+			err = true
+			return sol, err
+		}
+		if math.IsNaN(maxy) || math.IsInf(maxy, 0) {
+			return sol, err
+		}
+		temp0, temperr := LEs_ECPE(Matrix2ToSlices(B), NumProductMatrix(F, -1.0).Data)
+		//B奇异时LEs_ECPE给出非有限值
+		if !finite_NLEs_Newton(temp0) {
+			temperr = false
+		}
+		var xt, Ft Matrix
+		ok := false
+		fk := norm2_NLEs_Newton(F.Data)
+		fk = fk * fk / 2.0
+		if temperr {
+			d := NewMatrix(nx, 1, temp0)
+			//以Bk代替Jacobi矩阵估计方向导数
+			slope := -2.0 * fk
+			xt, Ft, ok = lineSearch_NLEs_Newton(funs, sol, d, fk, slope)
+		} else if fresh {
+			//Jacobi矩阵奇异，取最速下降方向-J'F
+			d := NumProductMatrix(DotPruduct(B.Transpose(), F), -1.0)
+			slope := 0.0
+			for i := 0; i < nx; i++ {
+				slope -= d.Data[i] * d.Data[i]
+			}
+			if slope < 0.0 {
+				xt, Ft, ok = lineSearch_NLEs_Newton(funs, sol, d, fk, slope)
+			}
+		}
+		if !ok {
+			if fresh {
+				return sol, err
+			}
+			//重新计算Jacobi矩阵
+			B = J(sol)
+			fresh = true
+			continue
+		}
+		//Broyden修正
+		s := SubMatrix(xt, sol)
+		y := SubMatrix(Ft, F)
+		ss := 0.0
+		for i := 0; i < nx; i++ {
+			ss += s.Data[i] * s.Data[i]
+		}
+		if ss > 0.0 {
+			r := SubMatrix(y, DotPruduct(B, s))
+			for i := 0; i < nx; i++ {
+				for j := 0; j < nx; j++ {
+					B.Data[i*nx+j] += r.Data[i] * s.Data[j] / ss
+				}
+			}
+		}
+		fresh = false
+		sol, F = xt, Ft
+	}
+	maxy, _, _ := MaxAbs(F.Data)
+	if math.Abs(maxy) < tol {
+		err = true
+	}
+	return sol, err
+}
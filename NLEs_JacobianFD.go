@@ -0,0 +1,67 @@
+// NLEs_JacobianFD
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    多元非线性方程组Jacobi矩阵的差分近似
+理论：
+    F = [f1, f2,..., fm]'，x = [x1, x2, ..., xn]'
+
+              fi(x + hj*ej) - fi(x)
+    J_ij ~= -----------------------
+                       hj
+
+    hj = sqrt(eps)*max(|xj|, 1)，eps为机器精度，截断误差与
+    舍入误差平衡，所得导数约有一半有效数字。
+
+    参考 J.E. Dennis and R.B. Schnabel. Numerical Methods for
+         Unconstrained Optimization and Nonlinear Equations.
+         SIAM, 1996. ss 5.4.
+------------------------------------------------------
+输入   :
+    funs    方程组，mx1
+    x       自变量，nx1
+输出   :
+    sol     Jacobi矩阵，mxn
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+// NLEs_JacobianFD 多元非线性方程组Jacobi矩阵的差分近似
+func NLEs_JacobianFD(funs func(Matrix) Matrix, x Matrix) Matrix {
+	/*
+		多元非线性方程组Jacobi矩阵的差分近似
+		输入   :
+		    funs    方程组，mx1
+		    x       自变量，nx1
+		输出   :
+		    sol     Jacobi矩阵，mxn
+	*/
+	//判断x维数
+	if x.Columns != 1 {
+		panic("Error in goNum.NLEs_JacobianFD: x is not a vector")
+	}
+
+	F0 := funs(x)
+	sol := ZeroMatrix(F0.Rows, x.Rows)
+	xt := ZeroMatrix(x.Rows, 1)
+	copy(xt.Data, x.Data)
+	for j := 0; j < x.Rows; j++ {
+		h := math.Sqrt(2.220446049250313e-16) * math.Max(math.Abs(x.Data[j]), 1.0)
+		xt.Data[j] = x.Data[j] + h
+		h = xt.Data[j] - x.Data[j] //消除表示误差
+		Fj := funs(xt)
+		for i := 0; i < F0.Rows; i++ {
+			sol.SetMatrix(i, j, (Fj.Data[i]-F0.Data[i])/h)
+		}
+		xt.Data[j] = x.Data[j]
+	}
+	return sol
+}
@@ -0,0 +1,87 @@
+// NLEs_JacobianFD_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    多元非线性方程组Jacobi矩阵的差分近似
+理论：
+    F = [f1, f2,..., fm]'，x = [x1, x2, ..., xn]'
+
+              fi(x + hj*ej) - fi(x)
+    J_ij ~= -----------------------
+                       hj
+
+    hj = sqrt(eps)*max(|xj|, 1)，eps为机器精度，截断误差与
+    舍入误差平衡，所得导数约有一半有效数字。
+
+    参考 J.E. Dennis and R.B. Schnabel. Numerical Methods for
+         Unconstrained Optimization and Nonlinear Equations.
+         SIAM, 1996. ss 5.4.
+------------------------------------------------------
+输入   :
+    funs    方程组，mx1
+    x       自变量，nx1
+输出   :
+    sol     Jacobi矩阵，mxn
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// NLEs_JacobianFD 多元非线性方程组Jacobi矩阵的差分近似
+func NLEs_JacobianFD(funs func(goNum.Matrix) goNum.Matrix, x goNum.Matrix) goNum.Matrix {
+	/*
+		多元非线性方程组Jacobi矩阵的差分近似
+		输入   :
+		    funs    方程组，mx1
+		    x       自变量，nx1
+		输出   :
+		    sol     Jacobi矩阵，mxn
+	*/
+	//判断x维数
+	if x.Columns != 1 {
+		panic("Error in goNum.NLEs_JacobianFD: x is not a vector")
+	}
+
+	F0 := funs(x)
+	sol := goNum.ZeroMatrix(F0.Rows, x.Rows)
+	xt := goNum.ZeroMatrix(x.Rows, 1)
+	copy(xt.Data, x.Data)
+	for j := 0; j < x.Rows; j++ {
+		h := math.Sqrt(2.220446049250313e-16) * math.Max(math.Abs(x.Data[j]), 1.0)
+		xt.Data[j] = x.Data[j] + h
+		h = xt.Data[j] - x.Data[j] //消除表示误差
+		Fj := funs(xt)
+		for i := 0; i < F0.Rows; i++ {
+			sol.SetMatrix(i, j, (Fj.Data[i]-F0.Data[i])/h)
+		}
+		xt.Data[j] = x.Data[j]
+	}
+	return sol
+}
+
+//F(x, y) = [10(y - x^2), 1 - x]'
+func fun83(x0 goNum.Matrix) goNum.Matrix {
+	sol := goNum.ZeroMatrix(2, 1)
+	x := x0.GetFromMatrix(0, 0)
+	y := x0.GetFromMatrix(1, 0)
+	sol.SetMatrix(0, 0, 10.0*(y-x*x))
+	sol.SetMatrix(1, 0, 1.0-x)
+	return sol
+}
+
+func BenchmarkNLEs_JacobianFD(b *testing.B) {
+	x83 := goNum.NewMatrix(2, 1, []float64{-1.2, 1.0})
+	for i := 0; i < b.N; i++ {
+		goNum.NLEs_JacobianFD(fun83, x83)
+	}
+}
@@ -0,0 +1,286 @@
+// NLEs_Newton_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    多元非线性方程组的全局化Newton法（线搜索、折线信赖域）
+理论：
+    F(x) = 0，F = [f1, f2,..., fn]'，Jk = F'(xk)，J为nil时
+    由NLEs_JacobianFD差分近似。Newton方向：
+    Jk*dk = -F(xk)
+    以f(x) = ||F(x)||^2/2为价值函数全局化：
+
+    method = 0，全步长Newton法：x_(k+1) = xk + dk
+
+    method = 1，Armijo回溯线搜索：x_(k+1) = xk + a*dk，a由1开始，
+    不满足
+    f(xk + a*dk) <= f(xk) - 2c*a*f(xk), c = 1e-4
+    时以二次插值缩小a（限于[0.1a, 0.5a]）。
+
+    method = 2，折线(dogleg)信赖域法，信赖域半径D：
+    梯度g = Jk'F(xk)，Cauchy点pC = -||g||^2/||Jk*g||^2*g，
+    ||dk|| <= D时取p = dk；||pC|| >= D时取p = -D*g/||g||；
+    否则取pC + tau(dk - pC)，tau使||p|| = D。
+    实际下降与模型m(p) = ||F + Jk*p||^2/2下降之比rho：
+    rho < 1/4时D = ||p||/4；rho > 3/4且||p|| = D时D = 2D；
+    rho > 1e-4时接受x_(k+1) = xk + p。
+
+    Jk奇异（LEs_ECPE给出非有限值）时：method = 0停止；method = 1
+    取最速下降方向dk = -g作线搜索；method = 2取p = -min(D, ||pC||)*
+    g/||g||。
+
+    ||F(x)||_inf < tol时停止。全局化方法可由远离解的初值收敛至
+    f的驻点（一般为解），接近解时恢复二次收敛。
+
+    参考 J. Nocedal and S.J. Wright. Numerical Optimization,
+         2nd ed. Springer, 2006. ss 3.1, 4.1, 11.2.
+------------------------------------------------------
+输入   :
+    funs    方程组，nx1
+    J       Jacobi矩阵，nxn，为nil时差分近似
+    x0      初值x
+    tol     控制误差
+    n       最大迭代次数
+    method  0-全步长，1-Armijo线搜索，2-折线信赖域
+输出   :
+    sol     解，nx1
+    err     解出标志：false-未解出或达到边界；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+//向量2范数
+func norm2_NLEs_Newton(a []float64) float64 {
+	var sol float64
+	for i := range a {
+		sol += a[i] * a[i]
+	}
+	return math.Sqrt(sol)
+}
+
+//向量各元素是否均为有限值
+func finite_NLEs_Newton(a []float64) bool {
+	for i := range a {
+		if math.IsNaN(a[i]) || math.IsInf(a[i], 0) {
+			return false
+		}
+	}
+	return true
+}
+
+//Armijo回溯线搜索，f0 = ||F(x)||^2/2，slope为f在d方向的方向导数
+func lineSearch_NLEs_Newton(funs func(goNum.Matrix) goNum.Matrix, x, d goNum.Matrix, f0, slope float64) (goNum.Matrix, goNum.Matrix, bool) {
+	xt := goNum.ZeroMatrix(x.Rows, 1)
+	a := 1.0
+	for k := 0; k < 40; k++ {
+		for i := 0; i < x.Rows; i++ {
+			xt.Data[i] = x.Data[i] + a*d.Data[i]
+		}
+		Ft := funs(xt)
+		ft := norm2_NLEs_Newton(Ft.Data)
+		ft = ft * ft / 2.0
+		if ft <= f0+1e-4*a*slope {
+			return xt, Ft, true
+		}
+		//二次插值
+		an := -slope * a * a / (2.0 * (ft - f0 - slope*a))
+		if math.IsNaN(an) || (an < 0.1*a) {
+			an = 0.1 * a
+		} else if an > 0.5*a {
+			an = 0.5 * a
+		}
+		a = an
+	}
+	return x, funs(x), false
+}
+
+// NLEs_Newton 多元非线性方程组的全局化Newton法（线搜索、折线信赖域）
+func NLEs_Newton(funs, J func(goNum.Matrix) goNum.Matrix, x0 goNum.Matrix, tol float64, n, method int) (goNum.Matrix, bool) {
+	/*
+		多元非线性方程组的全局化Newton法（线搜索、折线信赖域）
+		输入   :
+		    funs    方程组，nx1
+		    J       Jacobi矩阵，nxn，为nil时差分近似
+		    x0      初值x
+		    tol     控制误差
+		    n       最大迭代次数
+		    method  0-全步长，1-Armijo线搜索，2-折线信赖域
+		输出   :
+		    sol     解，nx1
+		    err     解出标志：false-未解出或达到边界；
+		                     true-全部解出
+	*/
+	//判断x维数
+	if x0.Columns != 1 {
+		panic("Error in goNum.NLEs_Newton: x0 is not a vector")
+	}
+	//判断method
+	if (method < 0) || (method > 2) {
+		panic("Error in goNum.NLEs_Newton: method is not in [0, 2]")
+	}
+	if J == nil {
+		J = func(x goNum.Matrix) goNum.Matrix { return goNum.NLEs_JacobianFD(funs, x) }
+	}
+
+	var err bool = false
+	nx := x0.Rows
+	sol := goNum.ZeroMatrix(nx, 1)
+	copy(sol.Data, x0.Data)
+	F := funs(sol)
+	delta := math.Max(1.0, norm2_NLEs_Newton(sol.Data)) //信赖域半径
+	for k := 0; k < n; k++ {
+		maxy, _, _ := goNum.MaxAbs(F.Data)
+		if math.Abs(maxy) < tol {
+			err = true
+			return sol, err
+		}
+		if math.IsNaN(maxy) || math.IsInf(maxy, 0) {
+			return sol, err
+		}
+		Jk := J(sol)
+		//Newton方向
+		temp0, temperr := goNum.LEs_ECPE(goNum.Matrix2ToSlices(Jk), goNum.NumProductMatrix(F, -1.0).Data)
+		//Jacobi矩阵奇异时LEs_ECPE给出非有限值
+		if !finite_NLEs_Newton(temp0) {
+			temperr = false
+		}
+		if (temperr != true) && (method == 0) {
+			return sol, err
+		}
+		d := goNum.NewMatrix(nx, 1, temp0)
+		fk := norm2_NLEs_Newton(F.Data)
+		fk = fk * fk / 2.0
+		//梯度J'F
+		g := goNum.DotPruduct(Jk.Transpose(), F)
+
+		switch method {
+		case 0:
+			sol = goNum.AddMatrix(sol, d)
+			F = funs(sol)
+		case 1:
+			slope := -2.0 * fk
+			if !temperr {
+				//Newton方向不存在，取最速下降方向
+				d = goNum.NumProductMatrix(g, -1.0)
+				slope = 0.0
+				for i := 0; i < nx; i++ {
+					slope -= g.Data[i] * g.Data[i]
+				}
+				if slope == 0.0 {
+					return sol, err
+				}
+			}
+			var ok bool
+			sol, F, ok = lineSearch_NLEs_Newton(funs, sol, d, fk, slope)
+			if !ok {
+				return sol, err
+			}
+		default:
+			//Cauchy点
+			Jg := goNum.DotPruduct(Jk, g)
+			ng := norm2_NLEs_Newton(g.Data)
+			nJg := norm2_NLEs_Newton(Jg.Data)
+			if ng == 0.0 {
+				return sol, err
+			}
+			pC := goNum.NumProductMatrix(g, -ng*ng/(nJg*nJg)) //nJg = 0时为无穷
+			var p goNum.Matrix
+			nd := math.Inf(1)
+			if temperr {
+				nd = norm2_NLEs_Newton(d.Data)
+			}
+			npC := norm2_NLEs_Newton(pC.Data)
+			switch {
+			case nd <= delta:
+				p = d
+			case (npC >= delta) || !temperr:
+				p = goNum.NumProductMatrix(g, -math.Min(delta, npC)/ng)
+			default:
+				//||pC + tau(d - pC)|| = delta
+				dp := goNum.SubMatrix(d, pC)
+				a := norm2_NLEs_Newton(dp.Data)
+				b := 0.0
+				for i := 0; i < nx; i++ {
+					b += pC.Data[i] * dp.Data[i]
+				}
+				c := npC*npC - delta*delta
+				tau := (-b + math.Sqrt(b*b-a*a*c)) / (a * a)
+				p = goNum.AddMatrix(pC, goNum.NumProductMatrix(dp, tau))
+			}
+			np := norm2_NLEs_Newton(p.Data)
+			xt := goNum.AddMatrix(sol, p)
+			Ft := funs(xt)
+			ft := norm2_NLEs_Newton(Ft.Data)
+			ft = ft * ft / 2.0
+			mp := norm2_NLEs_Newton(goNum.AddMatrix(F, goNum.DotPruduct(Jk, p)).Data)
+			mp = mp * mp / 2.0
+			rho := -1.0
+			if fk > mp {
+				rho = (fk - ft) / (fk - mp)
+			}
+			if math.IsNaN(ft) || (rho < 0.25) {
+				if math.IsNaN(np) || math.IsInf(np, 0) {
+					delta /= 4.0
+				} else {
+					delta = np / 4.0
+				}
+			} else if (rho > 0.75) && (np >= 0.99*delta) {
+				delta = 2.0 * delta
+			}
+			if !math.IsNaN(ft) && (rho > 1e-4) {
+				sol, F = xt, Ft
+			}
+			if delta < 1e-15*math.Max(1.0, norm2_NLEs_Newton(sol.Data)) {
+				return sol, err
+			}
+		}
+	}
+	maxy, _, _ := goNum.MaxAbs(F.Data)
+	if math.Abs(maxy) < tol {
+		err = true
+	}
+	return sol, err
+}
+
+func BenchmarkNLEs_Newton(b *testing.B) {
+	x83 := goNum.NewMatrix(2, 1, []float64{-1.2, 1.0})
+	for i := 0; i < b.N; i++ {
+		goNum.NLEs_Newton(fun83, nil, x83, 1e-10, 100, 1)
+		goNum.NLEs_Newton(fun83, nil, x83, 1e-10, 100, 2)
+	}
+}
+
+func fun101(x0 goNum.Matrix) goNum.Matrix {
+	x, y := x0.Data[0], x0.Data[1]
+	return goNum.NewMatrix(2, 1, []float64{x*x - y, x + y - 2.0})
+}
+
+func fun101J(x0 goNum.Matrix) goNum.Matrix {
+	return goNum.NewMatrix(2, 2, []float64{2.0 * x0.Data[0], -1.0, 1.0, 1.0})
+}
+
+func TestNLEs_NewtonSingular(t *testing.T) {
+	//x0 = (-0.5, 0)处Jacobi矩阵奇异，根(1, 1)
+	x0 := goNum.NewMatrix(2, 1, []float64{-0.5, 0.0})
+	sol, err := goNum.NLEs_Newton(fun101, fun101J, x0, 1e-10, 100, 0)
+	if err || (sol.Data[0] != -0.5) || (sol.Data[1] != 0.0) {
+		t.Errorf("method 0: %v, err = %v", sol.Data, err)
+	}
+	for _, method := range []int{1, 2} {
+		sol, err = goNum.NLEs_Newton(fun101, fun101J, x0, 1e-10, 100, method)
+		if !err || (math.Abs(sol.Data[0]-1.0) > 1e-8) || (math.Abs(sol.Data[1]-1.0) > 1e-8) {
+			t.Errorf("method %d: %v, err = %v", method, sol.Data, err)
+		}
+	}
+}
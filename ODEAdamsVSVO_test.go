@@ -0,0 +1,275 @@
+// ODEAdamsVSVO_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    变步长变阶Adams预估校正方法（方程组，1～12阶）
+理论：
+    对于常微分方程组 y' = f(x, y), y(x0) = y0
+
+    1. q阶Adams-Bashforth预估（过xn, ..., x_(n-q+1)的f插值多项式
+       Pq在[xn, x_(n+1)]上积分）：
+                       x_(n+1)
+       AB_q = yn +   |  Pq(x)dx
+                      xn
+    2. q+1阶Adams-Moulton校正（插值节点加入x_(n+1)，其f值为预估
+       值处的f）：
+                       x_(n+1)
+       AM_(q+1) = yn + |  P*_(q+1)(x)dx
+                        xn
+       以Lagrange基函数的积分为权重，节点不等距时同样适用。
+    3. 误差估计：AB_q的局部误差 E_q = ||AM_(q+1) - AB_q||，
+       ||.||为加权无穷范数，权重1/(1+|yi|)；E_k <= tol接受当前步，
+       取AM_(k+1)为新值并重新计算f（PECE）。
+    4. 变阶变步长：对q = k-1, k, k+1分别计算
+       h_q = h*min(2, max(0.5, 0.9*(tol/E_q)^(1/(q+1))))
+       取h_q最大者为新阶数与新步长；每步阶数至多变化1，升阶须
+       有足够的历史节点。首步取1阶，逐步升阶，无需启动值。
+
+    参考 L.F. Shampine and M.K. Gordon. Computer Solution of
+         Ordinary Differential Equations: the Initial Value
+         Problem. W.H. Freeman, 1975. ss 5-7.
+------------------------------------------------------
+输入   :
+    fun     第i个方程(计算变量值向量, i)
+    x0      初值向量，(fn+1)x1，一个x，fn个因变量
+    xend    终止x
+    tol     控制误差
+    fn      方程个数
+    kmax    最大阶数，1～12
+    n       最大积分步数
+输出   :
+    sol     解矩阵，(fn+1)xk，第一行为x
+    est     各步信息，2xk，第一行为所用阶数，第二行为局部误差估计
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+//Lagrange基函数在[a, b]上的积分，节点ts
+func weights_ODEAdamsVSVO(ts []float64, a, b float64) []float64 {
+	k := len(ts)
+	w := make([]float64, k)
+	hab := b - a
+	//节点映射至s = (x-a)/(b-a)
+	s := make([]float64, k)
+	for j := 0; j < k; j++ {
+		s[j] = (ts[j] - a) / hab
+	}
+	for j := 0; j < k; j++ {
+		//基函数多项式系数，低次在前
+		c := make([]float64, k)
+		c[0] = 1.0
+		deg := 0
+		den := 1.0
+		for m := 0; m < k; m++ {
+			if m == j {
+				continue
+			}
+			//乘以(s - s_m)
+			for p := deg + 1; p > 0; p-- {
+				c[p] = c[p-1] - s[m]*c[p]
+			}
+			c[0] = -s[m] * c[0]
+			deg++
+			den *= s[j] - s[m]
+		}
+		//[0, 1]上积分
+		sum := 0.0
+		for p := 0; p < k; p++ {
+			sum += c[p] / float64(p+1)
+		}
+		w[j] = hab * sum / den
+	}
+	return w
+}
+
+// ODEAdamsVSVO 变步长变阶Adams预估校正方法（方程组，1～12阶）
+func ODEAdamsVSVO(fun func(goNum.Matrix, int) float64, x0 goNum.Matrix, xend, tol float64,
+	fn, kmax, n int) (goNum.Matrix, goNum.Matrix, bool) {
+	/*
+		变步长变阶Adams预估校正方法（方程组，1～12阶）
+		输入   :
+		    fun     第i个方程(计算变量值向量, i)
+		    x0      初值向量，(fn+1)x1，一个x，fn个因变量
+		    xend    终止x
+		    tol     控制误差
+		    fn      方程个数
+		    kmax    最大阶数，1～12
+		    n       最大积分步数
+		输出   :
+		    sol     解矩阵，(fn+1)xk，第一行为x
+		    est     各步信息，2xk，第一行为所用阶数，第二行为局部误差估计
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断方程个数是否对应初值个数
+	if x0.Rows != fn+1 {
+		panic("Error in goNum.ODEAdamsVSVO: Quantities of x0 and fn+1 are not equal")
+	}
+	//判断tol值
+	if tol <= 0.0 {
+		panic("Error in goNum.ODEAdamsVSVO: tol less than or euqals to zero")
+	}
+	//判断xend值
+	if xend <= x0.Data[0] {
+		panic("Error in goNum.ODEAdamsVSVO: xend less than or euqals to x0")
+	}
+	//判断kmax值
+	if (kmax < 1) || (kmax > 12) {
+		panic("Error in goNum.ODEAdamsVSVO: kmax is not in [1, 12]")
+	}
+
+	var err bool = false
+	xyn := goNum.ZeroMatrix(fn+1, 1)
+	//f向量
+	fvec := func(x float64, y []float64) []float64 {
+		xyn.Data[0] = x
+		copy(xyn.Data[1:], y)
+		f := make([]float64, fn)
+		for i := 0; i < fn; i++ {
+			f[i] = fun(xyn, i)
+		}
+		return f
+	}
+	//加权无穷范数
+	wnorm := func(a, b []float64) float64 {
+		e := 0.0
+		for i := 0; i < fn; i++ {
+			e = math.Max(e, math.Abs(a[i]-b[i])/(1.0+math.Abs(a[i])))
+		}
+		return e
+	}
+	//步长因子
+	factor := func(e float64, q int) float64 {
+		if e <= 0.0 {
+			return 2.0
+		}
+		return math.Min(2.0, math.Max(0.5, 0.9*math.Pow(tol/e, 1.0/float64(q+1))))
+	}
+
+	t0 := x0.Data[0]
+	ts := []float64{t0}
+	ys := [][]float64{append([]float64{}, x0.Data[1:]...)}
+	fs := [][]float64{fvec(t0, ys[0])}
+	ks := []float64{0.0}
+	es := []float64{0.0}
+	h := (xend - t0) * math.Min(0.01, math.Sqrt(tol))
+	k := 1
+
+	for step := 0; step < n; step++ {
+		last := len(ts) - 1
+		tn := ts[last]
+		if tn >= xend {
+			break
+		}
+		if tn+h >= xend {
+			h = xend - tn
+		}
+		t1 := tn + h
+
+		//q阶预估，取xn, ..., x_(n-q+1)
+		ab := func(q int) []float64 {
+			w := weights_ODEAdamsVSVO(ts[last+1-q:last+1], tn, t1)
+			y := append([]float64{}, ys[last]...)
+			for j := 0; j < q; j++ {
+				for i := 0; i < fn; i++ {
+					y[i] += w[j] * fs[last+1-q+j][i]
+				}
+			}
+			return y
+		}
+		//q+1阶校正，f_(n+1)取fp
+		am := func(q int, fp []float64) []float64 {
+			tq := append(append([]float64{}, ts[last+1-q:last+1]...), t1)
+			w := weights_ODEAdamsVSVO(tq, tn, t1)
+			y := append([]float64{}, ys[last]...)
+			for j := 0; j < q; j++ {
+				for i := 0; i < fn; i++ {
+					y[i] += w[j] * fs[last+1-q+j][i]
+				}
+			}
+			for i := 0; i < fn; i++ {
+				y[i] += w[q] * fp[i]
+			}
+			return y
+		}
+
+		//预估-求值-校正
+		yp := ab(k)
+		fp := fvec(t1, yp)
+		yc := am(k, fp)
+		ek := wnorm(yc, yp)
+
+		if ek > tol {
+			//拒绝，缩小步长，必要时降阶
+			h = h * math.Max(0.2, factor(ek, k))
+			if k > 1 {
+				k--
+			}
+			if h < 1e-14*math.Max(math.Abs(tn), 1.0) {
+				break
+			}
+			continue
+		}
+
+		//接受，重新计算f
+		ts = append(ts, t1)
+		ys = append(ys, yc)
+		fs = append(fs, fvec(t1, yc))
+		ks = append(ks, float64(k))
+		es = append(es, ek)
+
+		//选择新阶数与步长
+		knew := k
+		hnew := h * factor(ek, k)
+		if k > 1 {
+			e := wnorm(am(k-1, fp), ab(k-1))
+			if hq := h * factor(e, k-1); hq > hnew {
+				knew, hnew = k-1, hq
+			}
+		}
+		if (k < kmax) && (k+1 <= last+1) {
+			e := wnorm(am(k+1, fp), ab(k+1))
+			if hq := h * factor(e, k+1); hq > hnew {
+				knew, hnew = k+1, hq
+			}
+		}
+		k = knew
+		h = hnew
+	}
+
+	//解矩阵
+	sol := goNum.ZeroMatrix(fn+1, len(ts))
+	est := goNum.ZeroMatrix(2, len(ts))
+	for j := range ts {
+		sol.SetMatrix(0, j, ts[j])
+		for i := 0; i < fn; i++ {
+			sol.SetMatrix(i+1, j, ys[j][i])
+		}
+		est.SetMatrix(0, j, ks[j])
+		est.SetMatrix(1, j, es[j])
+	}
+	if ts[len(ts)-1] >= xend {
+		err = true
+	}
+	return sol, est, err
+}
+
+func BenchmarkODEAdamsVSVO(b *testing.B) {
+	x68 := goNum.NewMatrix(3, 1, []float64{0.0, 0.0, 1.0})
+	for i := 0; i < b.N; i++ {
+		goNum.ODEAdamsVSVO(fun68, x68, 10.0, 1e-8, 2, 12, 10000)
+	}
+}
@@ -0,0 +1,168 @@
+// ODEBVPCollocation
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    三级Lobatto IIIA配置法求解非线性两点边值问题（可含未知参数）
+理论：
+    对于常微分方程组两点边值问题：
+    y' = f(x, y, p), a <= x <= b
+    g(y(a), y(b), p) = 0
+
+    在网格a = x0 < x1 < ... < xm = b上，以分段三次多项式在每段
+    两端点及中点处满足微分方程（Simpson配置，四阶精度），记
+    hj = x_(j+1) - xj, fj = f(xj, yj, p)：
+
+                 yj + y_(j+1)    hj
+    y_(j+1/2) = -------------- + ---(fj - f_(j+1))
+                      2           8
+
+                       hj
+    y_(j+1) - yj - ----(fj + 4f_(j+1/2) + f_(j+1)) = 0
+                       6
+
+    j = 0,1,...,m-1，连同边界条件g(y0, ym, p) = 0共fn*(m+1)+np
+    个非线性方程，使用差分近似Jacobi矩阵，由NLEs_SeidelIterate
+    进行牛顿迭代。
+
+    参考 L.F. Shampine, J. Kierzenka and M.W. Reichelt. Solving
+         boundary value problems for ordinary differential
+         equations in MATLAB with bvp4c. 2000.
+------------------------------------------------------
+输入   :
+    fun     第i个方程(计算变量值向量[x y1 ... yfn]', 参数向量p, i)
+    bc      边界条件残量g(ya, yb, p)，返回(fn+np)x1
+    x0      初始网格及猜测矩阵，(fn+1)x(m+1)，第一行为网格节点
+            a～b，其余各行为对应节点上y的猜测值
+    p0      未知参数初始猜测，npx1，无参数时为0x1
+    tol     控制误差
+    fn      方程个数
+    N       牛顿迭代最大次数
+输出   :
+    sol     解矩阵，(fn+1)x(m+1)，第一行为x
+    p       参数解，npx1
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+// ODEBVPCollocation 三级Lobatto IIIA配置法求解非线性两点边值问题（可含未知参数）
+func ODEBVPCollocation(fun func(Matrix, Matrix, int) float64, bc func(Matrix, Matrix, Matrix) Matrix,
+	x0, p0 Matrix, tol float64, fn, N int) (Matrix, Matrix, bool) {
+	/*
+		三级Lobatto IIIA配置法求解非线性两点边值问题（可含未知参数）
+		输入   :
+		    fun     第i个方程(计算变量值向量[x y1 ... yfn]', 参数向量p, i)
+		    bc      边界条件残量g(ya, yb, p)，返回(fn+np)x1
+		    x0      初始网格及猜测矩阵，(fn+1)x(m+1)，第一行为网格节点
+		            a～b，其余各行为对应节点上y的猜测值
+		    p0      未知参数初始猜测，npx1，无参数时为0x1
+		    tol     控制误差
+		    fn      方程个数
+		    N       牛顿迭代最大次数
+		输出   :
+		    sol     解矩阵，(fn+1)x(m+1)，第一行为x
+		    p       参数解，npx1
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断方程个数是否对应初值个数
+	if x0.Rows != fn+1 {
+		panic("Error in goNum.ODEBVPCollocation: Quantities of x0 and fn+1 are not equal")
+	}
+	//判断网格
+	if x0.Columns < 2 {
+		panic("Error in goNum.ODEBVPCollocation: Mesh nodes less than two")
+	}
+	for j := 1; j < x0.Columns; j++ {
+		if x0.GetFromMatrix(0, j) <= x0.GetFromMatrix(0, j-1) {
+			panic("Error in goNum.ODEBVPCollocation: Mesh nodes are not increasing")
+		}
+	}
+	//判断tol值
+	if tol <= 0.0 {
+		panic("Error in goNum.ODEBVPCollocation: tol less than or euqals to zero")
+	}
+
+	m := x0.Columns - 1
+	np := p0.Rows
+	z0 := ZeroMatrix(fn*(m+1)+np, 1) //未知量[y0; y1; ...; ym; p]
+	for j := 0; j < m+1; j++ {
+		for i := 0; i < fn; i++ {
+			z0.Data[j*fn+i] = x0.GetFromMatrix(i+1, j)
+		}
+	}
+	for i := 0; i < np; i++ {
+		z0.Data[fn*(m+1)+i] = p0.Data[i]
+	}
+
+	//计算节点x处的f(x, y, p)
+	xy := ZeroMatrix(fn+1, 1)
+	funf := func(x float64, y []float64, p Matrix) []float64 {
+		xy.Data[0] = x
+		for i := 0; i < fn; i++ {
+			xy.Data[i+1] = y[i]
+		}
+		f := make([]float64, fn)
+		for i := 0; i < fn; i++ {
+			f[i] = fun(xy, p, i)
+		}
+		return f
+	}
+
+	//配置残量函数
+	funs := func(z Matrix) Matrix {
+		F := ZeroMatrix(fn*(m+1)+np, 1)
+		p := NewMatrix(np, 1, append([]float64{}, z.Data[fn*(m+1):]...))
+		ymid := make([]float64, fn)
+		fj := funf(x0.GetFromMatrix(0, 0), z.Data[:fn], p)
+		for j := 0; j < m; j++ {
+			xj := x0.GetFromMatrix(0, j)
+			hj := x0.GetFromMatrix(0, j+1) - xj
+			yj := z.Data[j*fn : (j+1)*fn]
+			yj1 := z.Data[(j+1)*fn : (j+2)*fn]
+			fj1 := funf(xj+hj, yj1, p)
+			for i := 0; i < fn; i++ {
+				ymid[i] = (yj[i]+yj1[i])/2.0 + hj*(fj[i]-fj1[i])/8.0
+			}
+			fmid := funf(xj+hj/2.0, ymid, p)
+			for i := 0; i < fn; i++ {
+				F.Data[j*fn+i] = yj1[i] - yj[i] - hj*(fj[i]+4.0*fmid[i]+fj1[i])/6.0
+			}
+			fj = fj1
+		}
+		ya := NewMatrix(fn, 1, append([]float64{}, z.Data[:fn]...))
+		yb := NewMatrix(fn, 1, append([]float64{}, z.Data[m*fn:(m+1)*fn]...))
+		g := bc(ya, yb, p)
+		if g.Rows != fn+np {
+			panic("Error in goNum.ODEBVPCollocation: Quantities of bc and fn+np are not equal")
+		}
+		for i := 0; i < fn+np; i++ {
+			F.Data[fn*m+i] = g.Data[i]
+		}
+		return F
+	}
+	J := func(z Matrix) Matrix {
+		return jacobianFD_ODEBVPShooting(funs, z, funs(z))
+	}
+
+	//牛顿迭代
+	z, err := NLEs_SeidelIterate(funs, J, z0, tol, N)
+
+	sol := ZeroMatrix(fn+1, m+1)
+	for j := 0; j < m+1; j++ {
+		sol.SetMatrix(0, j, x0.GetFromMatrix(0, j))
+		for i := 0; i < fn; i++ {
+			sol.SetMatrix(i+1, j, z.Data[j*fn+i])
+		}
+	}
+	p := ZeroMatrix(np, 1)
+	for i := 0; i < np; i++ {
+		p.Data[i] = z.Data[fn*(m+1)+i]
+	}
+	return sol, p, err
+}
@@ -0,0 +1,211 @@
+// ODEBVPMultipleShooting_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    多重打靶法求解非线性两点边值问题（可含未知参数）
+理论：
+    对于常微分方程组两点边值问题：
+    y' = f(x, y, p), a <= x <= b
+    g(y(a), y(b), p) = 0
+
+    将[a, b]以打靶节点划分为M段：
+    a = x0 < x1 < ... < x_(M-1) < xM = b
+
+    以sk = y(xk)为第k段初值，使用RKF45积分至x_(k+1)得到
+    y(x_(k+1); sk, p)，则问题转化为求解fn*M+np维非线性方程组：
+    y(x_(k+1); sk, p) - s_(k+1) = 0, k = 0,1,...,M-2
+    g(s0, y(b; s_(M-1), p), p)  = 0
+
+    与单重打靶相比，每段积分区间缩短，对初值猜测的敏感性降低。
+    使用差分近似Jacobi矩阵，由NLEs_Newton进行带Armijo线搜索的
+    牛顿迭代，RKF45达到步数上限时处理同ODEBVPShooting。
+
+    参考 J. Stoer and R. Bulirsch. Introduction to Numerical
+         Analysis, 3rd ed. Springer, 2002. ss 7.3.5.
+------------------------------------------------------
+输入   :
+    fun     第i个方程(计算变量值向量[x y1 ... yfn]', 参数向量p, i)
+    bc      边界条件残量g(ya, yb, p)，返回(fn+np)x1
+    x0      初始猜测矩阵，(fn+1)xM，第一行为打靶节点x0～x_(M-1)，
+            其余各行为对应节点上y的猜测值
+    xend    终止x，即b
+    p0      未知参数初始猜测，npx1，无参数时为0x1
+    tol     控制误差，同时作为RKF45步长控制误差
+    fn      方程个数
+    n       每段RKF45最大积分步数
+    N       牛顿迭代最大次数
+输出   :
+    sol     解矩阵，(fn+1)xk，第一行为x，各段积分轨迹依次连接
+    p       参数解，npx1
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// ODEBVPMultipleShooting 多重打靶法求解非线性两点边值问题（可含未知参数）
+func ODEBVPMultipleShooting(fun func(goNum.Matrix, goNum.Matrix, int) float64, bc func(goNum.Matrix, goNum.Matrix, goNum.Matrix) goNum.Matrix,
+	x0 goNum.Matrix, xend float64, p0 goNum.Matrix, tol float64, fn, n, N int) (goNum.Matrix, goNum.Matrix, bool) {
+	/*
+		多重打靶法求解非线性两点边值问题（可含未知参数）
+		输入   :
+		    fun     第i个方程(计算变量值向量[x y1 ... yfn]', 参数向量p, i)
+		    bc      边界条件残量g(ya, yb, p)，返回(fn+np)x1
+		    x0      初始猜测矩阵，(fn+1)xM，第一行为打靶节点x0～x_(M-1)，
+		            其余各行为对应节点上y的猜测值
+		    xend    终止x，即b
+		    p0      未知参数初始猜测，npx1，无参数时为0x1
+		    tol     控制误差，同时作为RKF45步长控制误差
+		    fn      方程个数
+		    n       每段RKF45最大积分步数
+		    N       牛顿迭代最大次数
+		输出   :
+		    sol     解矩阵，(fn+1)xk，第一行为x，各段积分轨迹依次连接
+		    p       参数解，npx1
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断方程个数是否对应初值个数
+	if x0.Rows != fn+1 {
+		panic("Error in goNum.ODEBVPMultipleShooting: Quantities of x0 and fn+1 are not equal")
+	}
+	//判断tol值
+	if tol <= 0.0 {
+		panic("Error in goNum.ODEBVPMultipleShooting: tol less than or euqals to zero")
+	}
+	//判断打靶节点是否严格递增
+	M := x0.Columns
+	for k := 0; k < M; k++ {
+		var xnext float64 = xend
+		if k < M-1 {
+			xnext = x0.GetFromMatrix(0, k+1)
+		}
+		if xnext <= x0.GetFromMatrix(0, k) {
+			panic("Error in goNum.ODEBVPMultipleShooting: Shooting nodes are not increasing")
+		}
+	}
+
+	np := p0.Rows
+	z0 := goNum.ZeroMatrix(fn*M+np, 1) //未知量[s0; s1; ...; s_(M-1); p]
+	for k := 0; k < M; k++ {
+		for i := 0; i < fn; i++ {
+			z0.Data[k*fn+i] = x0.GetFromMatrix(i+1, k)
+		}
+	}
+	for i := 0; i < np; i++ {
+		z0.Data[fn*M+i] = p0.Data[i]
+	}
+	//第k段的终止x
+	xk1 := func(k int) float64 {
+		if k < M-1 {
+			return x0.GetFromMatrix(0, k+1)
+		}
+		return xend
+	}
+
+	//打靶残量函数
+	funs := func(z goNum.Matrix) goNum.Matrix {
+		F := goNum.ZeroMatrix(fn*M+np, 1)
+		p := goNum.NewMatrix(np, 1, append([]float64{}, z.Data[fn*M:]...))
+		yb := goNum.ZeroMatrix(fn, 1)
+		for k := 0; k < M; k++ {
+			soltemp, errtemp := integrate_ODEBVPShooting(fun, x0.GetFromMatrix(0, k),
+				z.Data[k*fn:(k+1)*fn], xk1(k), p, tol, fn, n)
+			if errtemp != true {
+				return nan_ODEBVPShooting(fn*M + np)
+			}
+			for i := 0; i < fn; i++ {
+				yend := soltemp.GetFromMatrix(i+1, soltemp.Columns-1)
+				if k < M-1 {
+					F.Data[k*fn+i] = yend - z.Data[(k+1)*fn+i] //连续性条件
+				} else {
+					yb.Data[i] = yend
+				}
+			}
+		}
+		ya := goNum.NewMatrix(fn, 1, append([]float64{}, z.Data[:fn]...))
+		g := bc(ya, yb, p)
+		if g.Rows != fn+np {
+			panic("Error in goNum.ODEBVPMultipleShooting: Quantities of bc and fn+np are not equal")
+		}
+		for i := 0; i < fn+np; i++ {
+			F.Data[fn*(M-1)+i] = g.Data[i]
+		}
+		return F
+	}
+	J := func(z goNum.Matrix) goNum.Matrix {
+		return jacobianFD_ODEBVPShooting(funs, z, funs(z))
+	}
+
+	//牛顿迭代，线搜索缩短步长
+	z, err := goNum.NLEs_Newton(funs, J, z0, tol, N, 1)
+
+	p := goNum.ZeroMatrix(np, 1)
+	for i := 0; i < np; i++ {
+		p.Data[i] = z.Data[fn*M+i]
+	}
+	//连接各段积分轨迹
+	sol := goNum.ZeroMatrix(0, fn+1)
+	for k := 0; k < M; k++ {
+		soltemp, _ := integrate_ODEBVPShooting(fun, x0.GetFromMatrix(0, k),
+			z.Data[k*fn:(k+1)*fn], xk1(k), p, tol, fn, n)
+		j0 := 1 //各段起点与上一段终点重合
+		if k == 0 {
+			j0 = 0
+		}
+		for j := j0; j < soltemp.Columns; j++ {
+			sol = sol.AppendRow(soltemp.ColumnOfMatrix(j))
+		}
+	}
+	return sol.Transpose(), p, err
+}
+
+func fun59(x0, p goNum.Matrix, i int) float64 {
+	switch i {
+	case 0:
+		return x0.Data[2]
+	case 1:
+		return -1.0 * p.Data[0] * x0.Data[1]
+	default:
+		return 0.0
+	}
+}
+
+func fun59bc(ya, yb, p goNum.Matrix) goNum.Matrix {
+	return goNum.NewMatrix(3, 1, []float64{ya.Data[0], ya.Data[1] - 1.0, yb.Data[0]})
+}
+
+func BenchmarkODEBVPMultipleShooting(b *testing.B) {
+	x59 := goNum.NewMatrix(3, 4, []float64{
+		0.0, 0.75, 1.5, 2.25,
+		0.0, 0.7, 1.0, 0.7,
+		1.0, 0.7, 0.0, -0.7})
+	p59 := goNum.NewMatrix(1, 1, []float64{1.3})
+	for i := 0; i < b.N; i++ {
+		goNum.ODEBVPMultipleShooting(fun59, fun59bc, x59, 3.141592653589793, p59, 1e-8, 2, 5000, 50)
+	}
+}
+
+func TestODEBVPMultipleShootingBadGuess(t *testing.T) {
+	//y'' = 1.5y^2，y(0) = 4，y(1) = 1，单重打靶在y'(0) = 10时失败
+	p58 := goNum.ZeroMatrix(0, 1)
+	for _, s := range []float64{10.0, 100.0} {
+		x58 := goNum.NewMatrix(3, 3, []float64{0.0, 0.3, 0.6, 4.0, 4.0, 4.0, s, s, s})
+		sol, _, err := goNum.ODEBVPMultipleShooting(fun58, fun58bc, x58, 1.0, p58, 1e-8, 2, 1000, 50)
+		//y = 4/(1+x)^2，y'(0) = -8
+		if !err || (math.Abs(sol.GetFromMatrix(2, 0)+8.0) > 1e-6) {
+			t.Errorf("s = %v: y'(0) = %v, err = %v", s, sol.GetFromMatrix(2, 0), err)
+		}
+	}
+}
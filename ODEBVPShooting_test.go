@@ -0,0 +1,218 @@
+// ODEBVPShooting_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    单重打靶法求解非线性两点边值问题（可含未知参数）
+理论：
+    对于常微分方程组两点边值问题：
+    y' = f(x, y, p), a <= x <= b
+    g(y(a), y(b), p) = 0
+
+    y为fn维向量，p为np维未知参数向量，g为fn+np维边界条件
+    残量函数。
+
+    以s = y(a)为打靶初值，使用RKF45积分至b得到y(b; s, p)，
+    则问题转化为求解fn+np维非线性方程组：
+    F(s, p) = g(s, y(b; s, p), p) = 0
+
+    使用差分近似Jacobi矩阵：
+             F(z+dzj*ej) - F(z)
+    J(:,j) = ------------------, dzj = sqrt(eps)*max(|zj|, 1)
+                    dzj
+    再由NLEs_Newton进行带Armijo线搜索的牛顿迭代。RKF45达到
+    步数上限（如初值猜测较差使解爆破）时残量取NaN，线搜索
+    缩短牛顿步长；无法继续时返回err = false。
+
+    参考 John H. Mathews and Kurtis D. Fink. Numerical
+         methods using MATLAB, 4th ed. Pearson
+         Education, 2004. ss 9.8.
+         J. Stoer and R. Bulirsch. Introduction to Numerical
+         Analysis, 3rd ed. Springer, 2002. ss 7.3.1.
+------------------------------------------------------
+输入   :
+    fun     第i个方程(计算变量值向量[x y1 ... yfn]', 参数向量p, i)
+    bc      边界条件残量g(ya, yb, p)，返回(fn+np)x1
+    x0      初始猜测向量，(fn+1)x1，一个a，fn个y(a)猜测值
+    xend    终止x，即b
+    p0      未知参数初始猜测，npx1，无参数时为0x1
+    tol     控制误差，同时作为RKF45步长控制误差
+    fn      方程个数
+    n       RKF45最大积分步数
+    N       牛顿迭代最大次数
+输出   :
+    sol     解矩阵，(fn+1)xk，第一行为x
+    p       参数解，npx1
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+//差分近似Jacobi矩阵，F0为F(z)
+func jacobianFD_ODEBVPShooting(F func(goNum.Matrix) goNum.Matrix, z, F0 goNum.Matrix) goNum.Matrix {
+	J := goNum.ZeroMatrix(F0.Rows, z.Rows)
+	zt := goNum.ZeroMatrix(z.Rows, 1)
+	for i := 0; i < z.Rows; i++ {
+		zt.Data[i] = z.Data[i]
+	}
+	for j := 0; j < z.Rows; j++ {
+		dz := math.Sqrt(2.220446049250313e-16) * math.Max(math.Abs(z.Data[j]), 1.0)
+		zt.Data[j] = z.Data[j] + dz
+		Fj := F(zt)
+		for i := 0; i < F0.Rows; i++ {
+			J.SetMatrix(i, j, (Fj.Data[i]-F0.Data[i])/dz)
+		}
+		zt.Data[j] = z.Data[j]
+	}
+	return J
+}
+
+//RKF45未解出时的残量，n个NaN
+func nan_ODEBVPShooting(n int) goNum.Matrix {
+	sol := goNum.ZeroMatrix(n, 1)
+	for i := range sol.Data {
+		sol.Data[i] = math.NaN()
+	}
+	return sol
+}
+
+//由x0和y0积分至xend，返回积分轨迹
+func integrate_ODEBVPShooting(fun func(goNum.Matrix, goNum.Matrix, int) float64, x0 float64,
+	y0 []float64, xend float64, p goNum.Matrix, tol float64, fn, n int) (goNum.Matrix, bool) {
+	xy0 := goNum.ZeroMatrix(fn+1, 1)
+	xy0.Data[0] = x0
+	for i := 0; i < fn; i++ {
+		xy0.Data[i+1] = y0[i]
+	}
+	funp := func(xy goNum.Matrix, i int) float64 {
+		return fun(xy, p, i)
+	}
+	return goNum.RKF45(funp, xy0, xend, tol, fn, n)
+}
+
+// ODEBVPShooting 单重打靶法求解非线性两点边值问题（可含未知参数）
+func ODEBVPShooting(fun func(goNum.Matrix, goNum.Matrix, int) float64, bc func(goNum.Matrix, goNum.Matrix, goNum.Matrix) goNum.Matrix,
+	x0 goNum.Matrix, xend float64, p0 goNum.Matrix, tol float64, fn, n, N int) (goNum.Matrix, goNum.Matrix, bool) {
+	/*
+		单重打靶法求解非线性两点边值问题（可含未知参数）
+		输入   :
+		    fun     第i个方程(计算变量值向量[x y1 ... yfn]', 参数向量p, i)
+		    bc      边界条件残量g(ya, yb, p)，返回(fn+np)x1
+		    x0      初始猜测向量，(fn+1)x1，一个a，fn个y(a)猜测值
+		    xend    终止x，即b
+		    p0      未知参数初始猜测，npx1，无参数时为0x1
+		    tol     控制误差，同时作为RKF45步长控制误差
+		    fn      方程个数
+		    n       RKF45最大积分步数
+		    N       牛顿迭代最大次数
+		输出   :
+		    sol     解矩阵，(fn+1)xk，第一行为x
+		    p       参数解，npx1
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断方程个数是否对应初值个数
+	if x0.Rows != fn+1 {
+		panic("Error in goNum.ODEBVPShooting: Quantities of x0 and fn+1 are not equal")
+	}
+	//判断tol值
+	if tol <= 0.0 {
+		panic("Error in goNum.ODEBVPShooting: tol less than or euqals to zero")
+	}
+	//判断xend值
+	if xend <= x0.Data[0] {
+		panic("Error in goNum.ODEBVPShooting: xend less than or euqals to x0")
+	}
+
+	np := p0.Rows
+	a := x0.Data[0]
+	z0 := goNum.ZeroMatrix(fn+np, 1) //未知量[s; p]
+	for i := 0; i < fn; i++ {
+		z0.Data[i] = x0.Data[i+1]
+	}
+	for i := 0; i < np; i++ {
+		z0.Data[fn+i] = p0.Data[i]
+	}
+
+	//打靶残量函数F(s, p)
+	funs := func(z goNum.Matrix) goNum.Matrix {
+		ya := goNum.NewMatrix(fn, 1, append([]float64{}, z.Data[:fn]...))
+		p := goNum.NewMatrix(np, 1, append([]float64{}, z.Data[fn:]...))
+		soltemp, errtemp := integrate_ODEBVPShooting(fun, a, ya.Data, xend, p, tol, fn, n)
+		if errtemp != true {
+			return nan_ODEBVPShooting(fn + np)
+		}
+		yb := goNum.ZeroMatrix(fn, 1)
+		for i := 0; i < fn; i++ {
+			yb.Data[i] = soltemp.GetFromMatrix(i+1, soltemp.Columns-1)
+		}
+		g := bc(ya, yb, p)
+		if g.Rows != fn+np {
+			panic("Error in goNum.ODEBVPShooting: Quantities of bc and fn+np are not equal")
+		}
+		return g
+	}
+	J := func(z goNum.Matrix) goNum.Matrix {
+		return jacobianFD_ODEBVPShooting(funs, z, funs(z))
+	}
+
+	//牛顿迭代，线搜索缩短步长
+	z, err := goNum.NLEs_Newton(funs, J, z0, tol, N, 1)
+
+	p := goNum.ZeroMatrix(np, 1)
+	for i := 0; i < np; i++ {
+		p.Data[i] = z.Data[fn+i]
+	}
+	sol, _ := integrate_ODEBVPShooting(fun, a, z.Data[:fn], xend, p, tol, fn, n)
+	return sol, p, err
+}
+
+func fun58(x0, p goNum.Matrix, i int) float64 {
+	switch i {
+	case 0:
+		return x0.Data[2]
+	case 1:
+		return 1.5 * x0.Data[1] * x0.Data[1]
+	default:
+		return 0.0
+	}
+}
+
+func fun58bc(ya, yb, p goNum.Matrix) goNum.Matrix {
+	return goNum.NewMatrix(2, 1, []float64{ya.Data[0] - 4.0, yb.Data[0] - 1.0})
+}
+
+func BenchmarkODEBVPShooting(b *testing.B) {
+	x58 := goNum.NewMatrix(3, 1, []float64{0.0, 4.0, -5.0})
+	p58 := goNum.ZeroMatrix(0, 1)
+	for i := 0; i < b.N; i++ {
+		goNum.ODEBVPShooting(fun58, fun58bc, x58, 1.0, p58, 1e-8, 2, 5000, 50)
+	}
+}
+
+func TestODEBVPShootingBadGuess(t *testing.T) {
+	//y'(0) = 10时RKF45达到步数上限，不应panic
+	p58 := goNum.ZeroMatrix(0, 1)
+	for _, s := range []float64{10.0, 100.0} {
+		_, _, err := goNum.ODEBVPShooting(fun58, fun58bc, goNum.NewMatrix(3, 1, []float64{0.0, 4.0, s}), 1.0, p58, 1e-8, 2, 1000, 50)
+		if err {
+			t.Errorf("s = %v: err = %v", s, err)
+		}
+	}
+	//y = 4/(1+x)^2，y'(0) = -8
+	sol, _, err := goNum.ODEBVPShooting(fun58, fun58bc, goNum.NewMatrix(3, 1, []float64{0.0, 4.0, 0.0}), 1.0, p58, 1e-8, 2, 1000, 50)
+	if !err || (math.Abs(sol.GetFromMatrix(2, 0)+8.0) > 1e-6) {
+		t.Errorf("s = 0: y'(0) = %v, err = %v", sol.GetFromMatrix(2, 0), err)
+	}
+}
@@ -0,0 +1,262 @@
+// ODEDAEBDF
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    变步长BDF法求解指标1微分代数方程组
+理论：
+    对于全隐式微分代数方程组(DAE)：
+    F(t, y, y') = 0, F为fn维，y(t0) = y0, y'(t0) = y'0
+    初值须相容，可先由ODEDAEInit计算。
+
+    1. k阶BDF：以过t_(n+1), tn, ..., t_(n+1-k)的插值多项式的
+       导数近似y'(t_(n+1))：
+                    k
+       y'_(n+1) =  Sum cj*y_(n+1-j)
+                   j=0
+       cj = lj'(t_(n+1))，lj为各节点Lagrange基函数，适用于变步长
+    2. 校正：求解F(t_(n+1), y_(n+1), y'_(n+1)) = 0，
+       Jacobi矩阵 dF/dy + c0*dF/dy' 以差分近似，残量按Jacobi矩阵
+       各行最大元平衡后由NLEs_SeidelIterate进行牛顿迭代；
+    3. 预估：过t_n, ..., t_(n-k)的插值多项式外推至t_(n+1)；
+    4. 误差估计与步长控制：
+                    h_(n+1)
+       err = -------------------- * ||y(c) - y(p)||
+              t_(n+1) - t_(n-k)
+       ||.||为加权无穷范数，权重1/(1+|yi|)；err <= tol接受，
+       h_new = h*min(2, max(0.2, 0.9*(tol/err)^(1/(k+1))))
+    5. 阶数从1逐步升至最大阶数kmax(<=5)。
+    牛顿迭代不收敛时步长缩小为1/4后重试。
+
+    参考 K.E. Brenan, S.L. Campbell and L.R. Petzold. Numerical
+         Solution of Initial-Value Problems in Differential-
+         Algebraic Equations. SIAM, 1996. ss 3.1, 5.2.
+------------------------------------------------------
+输入   :
+    fun     残量函数F(计算变量值向量[t y1 ... yfn]', y'向量)，
+            返回fnx1
+    x0      初值向量，(fn+1)x1，一个t，fn个因变量
+    yp0     y'初值向量，fnx1
+    tend    终止t
+    tol     控制误差
+    kmax    BDF最大阶数，1～5
+    n       最大积分步数
+输出   :
+    sol     解矩阵，(fn+1)xk，第一行为t
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+//Lagrange插值多项式在t处的值，节点ts，节点值ys
+func lagrange_ODEDAEBDF(ts []float64, ys [][]float64, t float64) []float64 {
+	sol := make([]float64, len(ys[0]))
+	for j := range ts {
+		lj := 1.0
+		for m := range ts {
+			if m != j {
+				lj *= (t - ts[m]) / (ts[j] - ts[m])
+			}
+		}
+		for i := range sol {
+			sol[i] += lj * ys[j][i]
+		}
+	}
+	return sol
+}
+
+//BDF系数cj = lj'(ts[0])
+func coef_ODEDAEBDF(ts []float64) []float64 {
+	k := len(ts) - 1
+	c := make([]float64, k+1)
+	for j := 1; j < k+1; j++ {
+		c[0] += 1.0 / (ts[0] - ts[j])
+		num, den := 1.0, 1.0
+		for m := 0; m < k+1; m++ {
+			if m != j {
+				den *= ts[j] - ts[m]
+				if m != 0 {
+					num *= ts[0] - ts[m]
+				}
+			}
+		}
+		c[j] = num / den
+	}
+	return c
+}
+
+// ODEDAEBDF 变步长BDF法求解指标1微分代数方程组
+func ODEDAEBDF(fun func(Matrix, Matrix) Matrix, x0, yp0 Matrix, tend, tol float64,
+	kmax, n int) (Matrix, bool) {
+	/*
+		变步长BDF法求解指标1微分代数方程组
+		输入   :
+		    fun     残量函数F(计算变量值向量[t y1 ... yfn]', y'向量)，
+		            返回fnx1
+		    x0      初值向量，(fn+1)x1，一个t，fn个因变量
+		    yp0     y'初值向量，fnx1
+		    tend    终止t
+		    tol     控制误差
+		    kmax    BDF最大阶数，1～5
+		    n       最大积分步数
+		输出   :
+		    sol     解矩阵，(fn+1)xk，第一行为t
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	fn := yp0.Rows
+	//判断方程个数是否对应初值个数
+	if x0.Rows != fn+1 {
+		panic("Error in goNum.ODEDAEBDF: Quantities of x0 and yp0+1 are not equal")
+	}
+	//判断tol值
+	if tol <= 0.0 {
+		panic("Error in goNum.ODEDAEBDF: tol less than or euqals to zero")
+	}
+	//判断tend值
+	if tend <= x0.Data[0] {
+		panic("Error in goNum.ODEDAEBDF: tend less than or euqals to t0")
+	}
+	//判断kmax值
+	if (kmax < 1) || (kmax > 5) {
+		panic("Error in goNum.ODEDAEBDF: kmax is not in [1, 5]")
+	}
+
+	var err bool = false
+	t0 := x0.Data[0]
+	ts := []float64{t0}
+	ys := [][]float64{append([]float64{}, x0.Data[1:]...)}
+	h := (tend - t0) * math.Min(0.01, math.Sqrt(tol))
+	xt := ZeroMatrix(fn+1, 1)
+	ypt := ZeroMatrix(fn, 1)
+
+	for step := 0; step < n; step++ {
+		last := len(ts) - 1
+		tn := ts[last]
+		if tn >= tend {
+			break
+		}
+		if tn+h >= tend {
+			h = tend - tn
+		}
+		t1 := tn + h
+		//当前阶数
+		k := last + 1
+		if k > kmax {
+			k = kmax
+		}
+
+		//预估
+		var yp []float64
+		if last == 0 {
+			yp = make([]float64, fn)
+			for i := 0; i < fn; i++ {
+				yp[i] = ys[0][i] + h*yp0.Data[i]
+			}
+		} else {
+			np := k + 1
+			if np > last+1 {
+				np = last + 1
+			}
+			yp = lagrange_ODEDAEBDF(ts[last+1-np:], ys[last+1-np:], t1)
+		}
+
+		//BDF节点t_(n+1), tn, ..., t_(n+1-k)
+		tk := make([]float64, k+1)
+		tk[0] = t1
+		for j := 1; j < k+1; j++ {
+			tk[j] = ts[last+1-j]
+		}
+		c := coef_ODEDAEBDF(tk)
+		rest := make([]float64, fn) //Sum_(j>=1) cj*y_(n+1-j)
+		for j := 1; j < k+1; j++ {
+			for i := 0; i < fn; i++ {
+				rest[i] += c[j] * ys[last+1-j][i]
+			}
+		}
+
+		//校正，牛顿迭代
+		funr := func(y Matrix) Matrix {
+			xt.Data[0] = t1
+			for i := 0; i < fn; i++ {
+				xt.Data[i+1] = y.Data[i]
+				ypt.Data[i] = c[0]*y.Data[i] + rest[i]
+			}
+			return fun(xt, ypt)
+		}
+		//按Jacobi矩阵行最大元平衡残量，使其与y同量级
+		ypm := NewMatrix(fn, 1, append([]float64{}, yp...))
+		J0 := jacobianFD_ODEBVPShooting(funr, ypm, funr(ypm))
+		D := make([]float64, fn)
+		for i := 0; i < fn; i++ {
+			rmax, _, _ := MaxAbs(J0.RowOfMatrix(i))
+			D[i] = 1.0 / math.Max(math.Abs(rmax), 1e-300)
+		}
+		funs := func(y Matrix) Matrix {
+			F := funr(y)
+			for i := 0; i < fn; i++ {
+				F.Data[i] *= D[i]
+			}
+			return F
+		}
+		J := func(y Matrix) Matrix {
+			return jacobianFD_ODEBVPShooting(funs, y, funs(y))
+		}
+		ycm, errN := NLEs_SeidelIterate(funs, J, ypm, tol/10.0, 10)
+		if !errN {
+			h = h / 4.0
+			if h < 1e-14*math.Max(math.Abs(tn), 1.0) {
+				break
+			}
+			continue
+		}
+
+		//误差估计
+		tp := ts[0]
+		if last+1 > k+1 {
+			tp = ts[last-k]
+		}
+		if last == 0 {
+			tp = tn - h //首步以Euler预估
+		}
+		e := 0.0
+		for i := 0; i < fn; i++ {
+			e = math.Max(e, math.Abs(ycm.Data[i]-yp[i])/(1.0+math.Abs(ycm.Data[i])))
+		}
+		e = e * h / (t1 - tp)
+
+		//步长调整
+		fac := 2.0
+		if e > 0.0 {
+			fac = math.Min(2.0, math.Max(0.2, 0.9*math.Pow(tol/e, 1.0/float64(k+1))))
+		}
+		if e > tol {
+			h = h * fac
+			continue
+		}
+		ts = append(ts, t1)
+		ys = append(ys, append([]float64{}, ycm.Data...))
+		h = h * fac
+	}
+
+	//解矩阵
+	sol := ZeroMatrix(fn+1, len(ts))
+	for j := range ts {
+		sol.SetMatrix(0, j, ts[j])
+		for i := 0; i < fn; i++ {
+			sol.SetMatrix(i+1, j, ys[j][i])
+		}
+	}
+	if ts[len(ts)-1] >= tend {
+		err = true
+	}
+	return sol, err
+}
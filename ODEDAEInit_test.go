@@ -0,0 +1,141 @@
+// ODEDAEInit_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    指标1微分代数方程组相容初值计算
+理论：
+    对于全隐式微分代数方程组(DAE)：
+    F(t, y, y') = 0, F为fn维
+
+    初值(y0, y'0)须满足F(t0, y0, y'0) = 0。将分量分为微分分量
+    (id[i] = true，y0_i给定)与代数分量(id[i] = false，y'0_i不
+    出现或无意义，取为零)，则以
+    [代数分量y0_i; 微分分量y'0_i]
+    为fn个未知量求解fn维非线性方程组F(t0, y0, y'0) = 0，对于
+    指标1问题其Jacobi矩阵非奇异。
+
+    使用差分近似Jacobi矩阵，由NLEs_SeidelIterate进行牛顿迭代。
+
+    参考 P.N. Brown, A.C. Hindmarsh and L.R. Petzold.
+         Consistent initial condition calculation for
+         differential-algebraic systems. SIAM J. Sci. Comput.,
+         1998, 19(5): 1495-1512.
+------------------------------------------------------
+输入   :
+    fun     残量函数F(计算变量值向量[t y1 ... yfn]', y'向量)，
+            返回fnx1
+    x0      初值猜测向量，(fn+1)x1，一个t，fn个因变量
+    yp0     y'初值猜测向量，fnx1
+    id      分量类型，true-微分分量；false-代数分量
+    tol     控制误差
+    N       牛顿迭代最大次数
+输出   :
+    x1      相容初值向量，(fn+1)x1
+    yp1     相容y'初值向量，fnx1
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// ODEDAEInit 指标1微分代数方程组相容初值计算
+func ODEDAEInit(fun func(goNum.Matrix, goNum.Matrix) goNum.Matrix, x0, yp0 goNum.Matrix, id []bool,
+	tol float64, N int) (goNum.Matrix, goNum.Matrix, bool) {
+	/*
+		指标1微分代数方程组相容初值计算
+		输入   :
+		    fun     残量函数F(计算变量值向量[t y1 ... yfn]', y'向量)，
+		            返回fnx1
+		    x0      初值猜测向量，(fn+1)x1，一个t，fn个因变量
+		    yp0     y'初值猜测向量，fnx1
+		    id      分量类型，true-微分分量；false-代数分量
+		    tol     控制误差
+		    N       牛顿迭代最大次数
+		输出   :
+		    x1      相容初值向量，(fn+1)x1
+		    yp1     相容y'初值向量，fnx1
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	fn := yp0.Rows
+	//判断维数
+	if (x0.Rows != fn+1) || (len(id) != fn) {
+		panic("Error in goNum.ODEDAEInit: Quantities of x0, yp0 and id are not matched")
+	}
+	//判断tol值
+	if tol <= 0.0 {
+		panic("Error in goNum.ODEDAEInit: tol less than or euqals to zero")
+	}
+
+	x1 := goNum.ZeroMatrix(fn+1, 1)
+	yp1 := goNum.ZeroMatrix(fn, 1)
+	z0 := goNum.ZeroMatrix(fn, 1) //未知量
+	for i := 0; i < fn+1; i++ {
+		x1.Data[i] = x0.Data[i]
+	}
+	for i := 0; i < fn; i++ {
+		if id[i] {
+			z0.Data[i] = yp0.Data[i]
+		} else {
+			z0.Data[i] = x0.Data[i+1]
+		}
+	}
+	//由未知量组装(x1, yp1)
+	assemble := func(z goNum.Matrix) {
+		for i := 0; i < fn; i++ {
+			if id[i] {
+				yp1.Data[i] = z.Data[i]
+			} else {
+				x1.Data[i+1] = z.Data[i]
+				yp1.Data[i] = 0.0
+			}
+		}
+	}
+	funs := func(z goNum.Matrix) goNum.Matrix {
+		assemble(z)
+		return fun(x1, yp1)
+	}
+	J := func(z goNum.Matrix) goNum.Matrix {
+		return jacobianFD_ODEBVPShooting(funs, z, funs(z))
+	}
+
+	//已相容则直接返回
+	assemble(z0)
+	F0 := fun(x1, yp1)
+	maxF, _, _ := goNum.MaxAbs(F0.Data)
+	if math.Abs(maxF) < tol {
+		return x1, yp1, true
+	}
+
+	z, err := goNum.NLEs_SeidelIterate(funs, J, z0, tol, N)
+	assemble(z)
+	return x1, yp1, err
+}
+
+func fun66(x0, yp goNum.Matrix) goNum.Matrix {
+	y1, y2, y3 := x0.Data[1], x0.Data[2], x0.Data[3]
+	return goNum.NewMatrix(3, 1, []float64{
+		yp.Data[0] + 0.04*y1 - 1e4*y2*y3,
+		yp.Data[1] - 0.04*y1 + 1e4*y2*y3 + 3e7*y2*y2,
+		y1 + y2 + y3 - 1.0})
+}
+
+func BenchmarkODEDAEInit(b *testing.B) {
+	x66 := goNum.NewMatrix(4, 1, []float64{0.0, 1.0, 0.0, 0.1})
+	yp66 := goNum.ZeroMatrix(3, 1)
+	id66 := []bool{true, true, false}
+	for i := 0; i < b.N; i++ {
+		goNum.ODEDAEInit(fun66, x66, yp66, id66, 1e-12, 20)
+	}
+}
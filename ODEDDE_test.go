@@ -0,0 +1,340 @@
+// ODEDDE_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    四级四阶Runge-Kutta法求解时滞微分方程组（含间断点跟踪）
+理论：
+    对于时滞微分方程组：
+    y'(t) = f(t, y(t), y(t-tau1), ..., y(t-taund)), t > t0
+    y(t)  = phi(t), t <= t0
+    taui = taui(t, y(t)) > 0 可为常数或依赖于状态
+
+    1. 积分格式：四级四阶Runge-Kutta法，步长不超过h；
+    2. 连续输出：在每一步[tn, t_(n+1)]上使用三次Hermite插值
+                 (yn, y_(n+1), fn, f_(n+1))，四阶精度，用以计算
+                 滞后值y(t-taui)；当t-taui落在当前步内（taui<h）时，
+                 以当前步的Hermite插值迭代修正；
+    3. 间断点跟踪：t0处的间断点xi沿各时滞传播，满足
+                   t - taui(t, y(t)) = xi
+                 的点t为新的间断点（光滑性提高一阶），在步内检测
+                 变号并以二分法求出，积分步恰好落在间断点上。
+                 只跟踪至第5级（超过RK44阶数后间断不影响精度）。
+
+    参考 L.F. Shampine and S. Thompson. Solving DDEs in MATLAB.
+         Applied Numerical Mathematics, 2001, 37: 441-458.
+         A. Bellen and M. Zennaro. Numerical Methods for Delay
+         Differential Equations. Oxford, 2003.
+------------------------------------------------------
+输入   :
+    fun     第i个方程(计算变量值向量[t y1 ... yfn]', 滞后值矩阵
+            fnxnd（第k列为y(t-tauk)）, i)
+    delays  时滞函数，输入[t y1 ... yfn]'，返回ndx1
+    history 历史函数，输入t<=t0，返回fnx1
+    t0      初始t
+    tend    终止t
+    h       最大步长
+    tol     间断点定位误差
+    fn      方程个数
+    nd      时滞个数
+输出   :
+    sol     解矩阵，(fn+1)xk，第一行为t
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+//三次Hermite插值
+func hermite_ODEDDE(t0, t1 float64, y0, y1, f0, f1 []float64, s float64) []float64 {
+	hh := t1 - t0
+	th := (s - t0) / hh
+	h00 := (1.0 + 2.0*th) * (1.0 - th) * (1.0 - th)
+	h10 := th * (1.0 - th) * (1.0 - th)
+	h01 := th * th * (3.0 - 2.0*th)
+	h11 := th * th * (th - 1.0)
+	sol := make([]float64, len(y0))
+	for i := range y0 {
+		sol[i] = h00*y0[i] + h10*hh*f0[i] + h01*y1[i] + h11*hh*f1[i]
+	}
+	return sol
+}
+
+// ODEDDE 四级四阶Runge-Kutta法求解时滞微分方程组（含间断点跟踪）
+func ODEDDE(fun func(goNum.Matrix, goNum.Matrix, int) float64, delays func(goNum.Matrix) goNum.Matrix,
+	history func(float64) goNum.Matrix, t0, tend, h, tol float64, fn, nd int) (goNum.Matrix, bool) {
+	/*
+		四级四阶Runge-Kutta法求解时滞微分方程组（含间断点跟踪）
+		输入   :
+		    fun     第i个方程(计算变量值向量[t y1 ... yfn]', 滞后值矩阵
+		            fnxnd（第k列为y(t-tauk)）, i)
+		    delays  时滞函数，输入[t y1 ... yfn]'，返回ndx1
+		    history 历史函数，输入t<=t0，返回fnx1
+		    t0      初始t
+		    tend    终止t
+		    h       最大步长
+		    tol     间断点定位误差
+		    fn      方程个数
+		    nd      时滞个数
+		输出   :
+		    sol     解矩阵，(fn+1)xk，第一行为t
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断tend值
+	if tend <= t0 {
+		panic("Error in goNum.ODEDDE: tend less than or euqals to t0")
+	}
+	//判断h与tol
+	if (h <= 0.0) || (tol <= 0.0) {
+		panic("Error in goNum.ODEDDE: h or tol less than or euqals to zero")
+	}
+
+	const maxLevel int = 5 //间断点跟踪级数上限
+	var err bool = false
+
+	//已求得的解、导数
+	ts := []float64{t0}
+	y0 := history(t0)
+	if y0.Rows != fn {
+		panic("Error in goNum.ODEDDE: Quantities of history and fn are not equal")
+	}
+	ys := [][]float64{append([]float64{}, y0.Data...)}
+	fs := [][]float64{}
+
+	//当前步的试探值，用于t-tau落在当前步内的情况
+	var curOn bool = false
+	var curT1 float64
+	var curY1, curF1 []float64
+
+	//滞后值y(s)
+	ylag := func(s float64) []float64 {
+		last := len(ts) - 1
+		if s <= t0 {
+			return history(s).Data
+		}
+		if s <= ts[last] {
+			//二分查找所在区间
+			lo, hi := 0, last
+			for hi-lo > 1 {
+				mid := (lo + hi) / 2
+				if ts[mid] <= s {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			return hermite_ODEDDE(ts[lo], ts[hi], ys[lo], ys[hi], fs[lo], fs[hi], s)
+		}
+		//落在当前步内
+		if curOn {
+			return hermite_ODEDDE(ts[last], curT1, ys[last], curY1, fs[last], curF1, s)
+		}
+		if last > 0 { //上一步插值外推
+			return hermite_ODEDDE(ts[last-1], ts[last], ys[last-1], ys[last], fs[last-1], fs[last], s)
+		}
+		sol := make([]float64, fn) //第一步Euler外推
+		for i := 0; i < fn; i++ {
+			sol[i] = ys[0][i] + (s-t0)*fs[0][i]
+		}
+		return sol
+	}
+
+	//右端函数及时滞
+	xy := goNum.ZeroMatrix(fn+1, 1)
+	tauOf := func(t float64, y []float64) goNum.Matrix {
+		xy.Data[0] = t
+		copy(xy.Data[1:], y)
+		tau := delays(xy)
+		if tau.Rows != nd {
+			panic("Error in goNum.ODEDDE: Quantities of delays and nd are not equal")
+		}
+		return tau
+	}
+	overlap := false //是否存在t-tau落在当前步内
+	evalf := func(t float64, y []float64) []float64 {
+		tau := tauOf(t, y)
+		Z := goNum.ZeroMatrix(fn, nd)
+		for k := 0; k < nd; k++ {
+			if tau.Data[k] < 0.0 {
+				panic("Error in goNum.ODEDDE: Negative delay")
+			}
+			if t-tau.Data[k] > ts[len(ts)-1] {
+				overlap = true
+			}
+			yk := ylag(t - tau.Data[k])
+			for i := 0; i < fn; i++ {
+				Z.SetMatrix(i, k, yk[i])
+			}
+		}
+		xy.Data[0] = t
+		copy(xy.Data[1:], y)
+		f := make([]float64, fn)
+		for i := 0; i < fn; i++ {
+			f[i] = fun(xy, Z, i)
+		}
+		return f
+	}
+
+	//RK44单步，返回y_(n+1)与f_(n+1)
+	step := func(tn float64, yn, fn0 []float64, hn float64) ([]float64, []float64) {
+		yt := make([]float64, fn)
+		k1 := fn0
+		for i := 0; i < fn; i++ {
+			yt[i] = yn[i] + hn*k1[i]/2.0
+		}
+		k2 := evalf(tn+hn/2.0, yt)
+		for i := 0; i < fn; i++ {
+			yt[i] = yn[i] + hn*k2[i]/2.0
+		}
+		k3 := evalf(tn+hn/2.0, yt)
+		for i := 0; i < fn; i++ {
+			yt[i] = yn[i] + hn*k3[i]
+		}
+		k4 := evalf(tn+hn, yt)
+		y1 := make([]float64, fn)
+		for i := 0; i < fn; i++ {
+			y1[i] = yn[i] + hn*(k1[i]+2.0*k2[i]+2.0*k3[i]+k4[i])/6.0
+		}
+		return y1, evalf(tn+hn, y1)
+	}
+	//含迭代修正的单步
+	stepIter := func(tn float64, yn, fn0 []float64, hn float64) ([]float64, []float64) {
+		curOn = false
+		overlap = false
+		y1, f1 := step(tn, yn, fn0, hn)
+		for it := 0; overlap && (it < 10); it++ {
+			curOn, curT1, curY1, curF1 = true, tn+hn, y1, f1
+			overlap = false
+			y2, f2 := step(tn, yn, fn0, hn)
+			dy := 0.0
+			for i := 0; i < fn; i++ {
+				dy = math.Max(dy, math.Abs(y2[i]-y1[i]))
+			}
+			y1, f1 = y2, f2
+			if dy < tol {
+				break
+			}
+		}
+		curOn = false
+		return y1, f1
+	}
+
+	//间断点
+	discs := []float64{t0}
+	levels := []int{0}
+	passed := [][]bool{make([]bool, nd)}
+
+	fs = append(fs, evalf(t0, ys[0]))
+	for ts[len(ts)-1] < tend {
+		n := len(ts) - 1
+		tn, yn, fn0 := ts[n], ys[n], fs[n]
+		hn := math.Min(h, tend-tn)
+		if tend-(tn+hn) < 1e-12*h {
+			hn = tend - tn
+		}
+		y1, f1 := stepIter(tn, yn, fn0, hn)
+
+		//检测间断点传播 g(t) = t - tauk(t, y(t)) - xi
+		tstar := tn + hn
+		var hits [][2]int
+		gOf := func(t float64, y []float64, d, k int) float64 {
+			return t - tauOf(t, y).Data[k] - discs[d]
+		}
+		for d := range discs {
+			if levels[d] >= maxLevel {
+				continue
+			}
+			for k := 0; k < nd; k++ {
+				if passed[d][k] || (gOf(tn+hn, y1, d, k) < 0.0) {
+					continue
+				}
+				//二分法定位
+				a, b := tn, tn+hn
+				for (b - a) > tol {
+					c := (a + b) / 2.0
+					yc := hermite_ODEDDE(tn, tn+hn, yn, y1, fn0, f1, c)
+					if gOf(c, yc, d, k) < 0.0 {
+						a = c
+					} else {
+						b = c
+					}
+				}
+				switch {
+				case b < tstar-tol:
+					tstar = b
+					hits = [][2]int{{d, k}}
+				case b <= tstar+tol:
+					hits = append(hits, [2]int{d, k})
+				}
+			}
+		}
+		//重新积分至间断点
+		if tstar < tn+hn-tol {
+			y1, f1 = stepIter(tn, yn, fn0, tstar-tn)
+		} else {
+			tstar = tn + hn
+		}
+		if len(hits) > 0 {
+			newLevel := maxLevel
+			for _, dk := range hits {
+				passed[dk[0]][dk[1]] = true
+				if levels[dk[0]]+1 < newLevel {
+					newLevel = levels[dk[0]] + 1
+				}
+			}
+			discs = append(discs, tstar)
+			levels = append(levels, newLevel)
+			passed = append(passed, make([]bool, nd))
+		}
+
+		ts = append(ts, tstar)
+		ys = append(ys, y1)
+		fs = append(fs, f1)
+	}
+
+	//解矩阵
+	sol := goNum.ZeroMatrix(fn+1, len(ts))
+	for j := range ts {
+		sol.SetMatrix(0, j, ts[j])
+		for i := 0; i < fn; i++ {
+			sol.SetMatrix(i+1, j, ys[j][i])
+		}
+	}
+
+	err = true
+	return sol, err
+}
+
+func fun61(x0, Z goNum.Matrix, i int) float64 {
+	switch i {
+	case 0:
+		return -1.0 * Z.Data[0]
+	default:
+		return 0.0
+	}
+}
+
+func fun61tau(x0 goNum.Matrix) goNum.Matrix {
+	return goNum.NewMatrix(1, 1, []float64{1.0 + 0.5*x0.Data[1]*x0.Data[1]})
+}
+
+func fun61phi(t float64) goNum.Matrix {
+	return goNum.NewMatrix(1, 1, []float64{1.0})
+}
+
+func BenchmarkODEDDE(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		goNum.ODEDDE(fun61, fun61tau, fun61phi, 0.0, 5.0, 0.05, 1e-12, 1, 1)
+	}
+}
@@ -0,0 +1,132 @@
+// ODEHammingSys_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    Hamming预估校正方法（方程组，RK44自启动）
+理论：
+    预估：
+                         4h
+    p_(k+1) = y_(k-3) + ---(2f_(k-2)-f_(k-1)+2fk)
+                         3
+    校正：
+               -y_(k-2)+9yk     3h
+    y_(k+1) = -------------- + ---(-f_(k-1)+2fk+f_(k+1))
+                     8          8
+    其中y、f均为fn维向量，f_(k+1) = f(x_(k+1), p_(k+1))
+
+    步长 h < 0.69/|fy(x,y)|
+
+    四阶精度
+
+    前三步由RK44计算，无需提供启动值。
+
+    参考：John H. Mathews and Kurtis D. Fink. Numerical
+         methods using MATLAB, 4th ed. Pearson
+         Education, 2004. ss 9.6.6
+------------------------------------------------------
+输入   :
+    fun     第i个方程(计算变量值向量, i)
+    x0      初值向量，(fn+1)x1，一个x，fn个因变量
+    xend    终止x
+    fn      方程个数
+    n       积分步数，不小于3
+输出   :
+    sol     解矩阵，(fn+1)x(n+1)，第一行为x
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// ODEHammingSys Hamming预估校正方法（方程组，RK44自启动）
+func ODEHammingSys(fun func(goNum.Matrix, int) float64, x0 goNum.Matrix, xend float64, fn, n int) (goNum.Matrix, bool) {
+	/*
+		Hamming预估校正方法（方程组，RK44自启动）
+		输入   :
+		    fun     第i个方程(计算变量值向量, i)
+		    x0      初值向量，(fn+1)x1，一个x，fn个因变量
+		    xend    终止x
+		    fn      方程个数
+		    n       积分步数，不小于3
+		输出   :
+		    sol     解矩阵，(fn+1)x(n+1)，第一行为x
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断方程个数是否对应初值个数
+	if x0.Rows != fn+1 {
+		panic("Error in goNum.ODEHammingSys: Quantities of x0 and fn+1 are not equal")
+	}
+	//判断n
+	if n < 3 {
+		panic("Error in goNum.ODEHammingSys: n less than three")
+	}
+
+	sol := goNum.ZeroMatrix(fn+1, n+1)
+	F := goNum.ZeroMatrix(fn, n+1) //各步f值
+	var err bool = false
+	h := (xend - x0.Data[0]) / float64(n)
+
+	//前三步使用RK44计算，包括初值点
+	xendRK := x0.Data[0] + 3.0*h
+	solRK, errRK := goNum.RK44(fun, x0, xendRK, fn, 3)
+	if errRK != true {
+		panic("Error in goNum.ODEHammingSys: RK44 solving error")
+	}
+	xyn := goNum.ZeroMatrix(fn+1, 1)
+	for i := 0; i < 4; i++ {
+		for k := 0; k < fn+1; k++ {
+			sol.SetMatrix(k, i, solRK.GetFromMatrix(k, i))
+			xyn.Data[k] = solRK.GetFromMatrix(k, i)
+		}
+		for j := 0; j < fn; j++ {
+			F.SetMatrix(j, i, fun(xyn, j))
+		}
+	}
+
+	//计算
+	xyp := goNum.ZeroMatrix(fn+1, 1) //预估值
+	for i := 4; i < n+1; i++ {
+		sol.SetMatrix(0, i, sol.GetFromMatrix(0, i-1)+h) //xi
+		xyp.Data[0] = sol.GetFromMatrix(0, i)
+		//pi
+		for j := 0; j < fn; j++ {
+			temp0 := 2.0*F.GetFromMatrix(j, i-3) - F.GetFromMatrix(j, i-2) + 2.0*F.GetFromMatrix(j, i-1)
+			xyp.Data[j+1] = sol.GetFromMatrix(j+1, i-4) + 4.0*h*temp0/3.0
+		}
+		//yi
+		for j := 0; j < fn; j++ {
+			temp0 := -1.0*F.GetFromMatrix(j, i-2) + 2.0*F.GetFromMatrix(j, i-1) + fun(xyp, j)
+			temp0 = 3.0 * h * temp0 / 8.0
+			temp0 += (-1.0*sol.GetFromMatrix(j+1, i-3) + 9.0*sol.GetFromMatrix(j+1, i-1)) / 8.0
+			sol.SetMatrix(j+1, i, temp0)
+		}
+		//fi
+		for k := 0; k < fn+1; k++ {
+			xyn.Data[k] = sol.GetFromMatrix(k, i)
+		}
+		for j := 0; j < fn; j++ {
+			F.SetMatrix(j, i, fun(xyn, j))
+		}
+	}
+
+	err = true
+	return sol, err
+}
+
+func BenchmarkODEHammingSys(b *testing.B) {
+	x68 := goNum.NewMatrix(3, 1, []float64{0.0, 0.0, 1.0})
+	for i := 0; i < b.N; i++ {
+		goNum.ODEHammingSys(fun68, x68, 2.0, 2, 40)
+	}
+}
@@ -0,0 +1,312 @@
+// PDEDiffEllipticalGeneral
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    求解变系数椭圆型偏微分方程的差分解法（一般边界条件，非均匀网格）
+理论：
+    对于椭圆型偏微分方程：
+          d      du      d      du
+    -(---(k---) + ---(k---)) + c(x, y)u = f(x, y)
+         dx      dx     dy      dy
+    k = k(x, y) > 0
+
+    各边界：p*u + q*k*du/dn = g，n为外法向，边号edge：
+    0-y=y0，1-y=b，2-x=x0，3-x=a
+    q = 0为Dirichlet边界，p = 0为Neumann边界，否则为Robin边界。
+
+    x、y方向节点x0 < x1 < ... < xn，y0 < y1 < ... < ym可不等距。
+
+    1. 五点格式（nine = false）：以节点(xi, yj)为中心的控制体
+       [x_(i-1/2), x_(i+1/2)]x[y_(j-1/2), y_(j+1/2)]积分：
+       -k_(i+1/2,j)(u_(i+1,j)-u_(i,j))/(x_(i+1)-x_i)*dyj + ...
+       + c_(i,j)u_(i,j)*dxi*dyj = f_(i,j)*dxi*dyj
+       k_(i+1/2,j)为边中点处的k值；边界上的控制体取一半，边界
+       通量由k*du/dn = (g - p*u)/q给出。二阶精度。
+    2. 九点紧致格式（nine = true）：要求等距网格、k为常数且各边
+       均为Dirichlet边界，记D = dx^2/hx^2 + dy^2/hy^2，
+                       hx^2 + hy^2  dx^2dy^2
+       -k*(D u + ----------- ---------- u) + M(c*u) = M f
+                           12       hx^2hy^2
+       M = 1 + dx^2/12 + dy^2/12，四阶精度。
+
+    按y逐行编号，所得方程组半带宽为n+2，由带状Gauss消去法求解。
+
+    参考 R.J. LeVeque. Finite Difference Methods for Ordinary
+         and Partial Differential Equations. SIAM, 2007. ss 3.
+------------------------------------------------------
+输入   :
+    funk    扩散系数k(x, y)
+    funcc   系数c(x, y)
+    funf    右端项f(x, y)
+    bc      边界函数(x, y, edge)，返回(p, q, g)
+    xs      x方向节点，(n+1)x1
+    ys      y方向节点，(m+1)x1
+    nine    true-九点四阶格式；false-五点格式
+输出   :
+    sol     解矩阵，(m+1)x(n+1)，行y变化，列x变化
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+//带状矩阵Gauss消去，不选主元，半带宽bw，A为Nx(2bw+1)带状存储，
+//A[i][j-i+bw]为原矩阵第i行第j列元素
+func bandSolve_PDEDiffEllipticalGeneral(A [][]float64, b []float64, bw int) ([]float64, bool) {
+	N := len(b)
+	for k := 0; k < N; k++ {
+		if A[k][bw] == 0.0 {
+			return nil, false
+		}
+		iend := k + bw + 1
+		if iend > N {
+			iend = N
+		}
+		for i := k + 1; i < iend; i++ {
+			if A[i][k-i+bw] == 0.0 {
+				continue
+			}
+			l := A[i][k-i+bw] / A[k][bw]
+			for j := k; j < iend; j++ {
+				A[i][j-i+bw] -= l * A[k][j-k+bw]
+			}
+			b[i] -= l * b[k]
+		}
+	}
+	sol := make([]float64, N)
+	for k := N - 1; k >= 0; k-- {
+		jend := k + bw + 1
+		if jend > N {
+			jend = N
+		}
+		temp0 := b[k]
+		for j := k + 1; j < jend; j++ {
+			temp0 -= A[k][j-k+bw] * sol[j]
+		}
+		sol[k] = temp0 / A[k][bw]
+	}
+	return sol, true
+}
+
+// PDEDiffEllipticalGeneral 求解变系数椭圆型偏微分方程的差分解法（一般边界条件，非均匀网格）
+func PDEDiffEllipticalGeneral(funk, funcc, funf func(float64, float64) float64,
+	bc func(float64, float64, int) (float64, float64, float64), xs, ys Matrix, nine bool) (Matrix, bool) {
+	/*
+		求解变系数椭圆型偏微分方程的差分解法（一般边界条件，非均匀网格）
+		输入   :
+		    funk    扩散系数k(x, y)
+		    funcc   系数c(x, y)
+		    funf    右端项f(x, y)
+		    bc      边界函数(x, y, edge)，返回(p, q, g)
+		    xs      x方向节点，(n+1)x1
+		    ys      y方向节点，(m+1)x1
+		    nine    true-九点四阶格式；false-五点格式
+		输出   :
+		    sol     解矩阵，(m+1)x(n+1)，行y变化，列x变化
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	n := xs.Rows - 1
+	m := ys.Rows - 1
+	//判断网格数量
+	if (m < 2) || (n < 2) {
+		panic("Error in goNum.PDEDiffEllipticalGeneral: Grid numbers error")
+	}
+	//判断节点单调
+	for i := 0; i < n; i++ {
+		if xs.Data[i+1] <= xs.Data[i] {
+			panic("Error in goNum.PDEDiffEllipticalGeneral: xs is not increasing")
+		}
+	}
+	for j := 0; j < m; j++ {
+		if ys.Data[j+1] <= ys.Data[j] {
+			panic("Error in goNum.PDEDiffEllipticalGeneral: ys is not increasing")
+		}
+	}
+
+	var err bool = false
+	sol := ZeroMatrix(m+1, n+1) //行y变化，列x变化
+	N := (n + 1) * (m + 1)
+	id := func(i, j int) int { return j*(n+1) + i }
+	bw := n + 2 //半带宽
+	AA := make([][]float64, N)
+	for k := 0; k < N; k++ {
+		AA[k] = make([]float64, 2*bw+1)
+	}
+	BA := make([]float64, N)
+	//系数矩阵第r行第c列元素加v
+	add := func(r, c int, v float64) {
+		AA[r][c-r+bw] += v
+	}
+
+	//节点(i, j)所在的边，Dirichlet边优先
+	edges := func(i, j int) []int {
+		var e []int
+		if j == 0 {
+			e = append(e, 0)
+		}
+		if j == m {
+			e = append(e, 1)
+		}
+		if i == 0 {
+			e = append(e, 2)
+		}
+		if i == n {
+			e = append(e, 3)
+		}
+		return e
+	}
+	//Dirichlet节点赋值，返回是否为Dirichlet节点
+	dirichlet := func(i, j int) bool {
+		x, y := xs.Data[i], ys.Data[j]
+		for _, e := range edges(i, j) {
+			p, q, g := bc(x, y, e)
+			if q == 0.0 {
+				if p == 0.0 {
+					panic("Error in goNum.PDEDiffEllipticalGeneral: Boundary condition error")
+				}
+				AA[id(i, j)][bw] = 1.0
+				BA[id(i, j)] = g / p
+				return true
+			}
+		}
+		return false
+	}
+
+	if nine {
+		//九点格式条件判断
+		hx := xs.Data[1] - xs.Data[0]
+		hy := ys.Data[1] - ys.Data[0]
+		for i := 1; i < n; i++ {
+			if math.Abs(xs.Data[i+1]-xs.Data[i]-hx) > 1e-10*hx {
+				panic("Error in goNum.PDEDiffEllipticalGeneral: xs is not uniform for nine-point stencil")
+			}
+		}
+		for j := 1; j < m; j++ {
+			if math.Abs(ys.Data[j+1]-ys.Data[j]-hy) > 1e-10*hy {
+				panic("Error in goNum.PDEDiffEllipticalGeneral: ys is not uniform for nine-point stencil")
+			}
+		}
+		k0 := funk(xs.Data[0], ys.Data[0])
+		for j := 0; j < m+1; j++ {
+			for i := 0; i < n+1; i++ {
+				if math.Abs(funk(xs.Data[i], ys.Data[j])-k0) > 1e-12*math.Abs(k0) {
+					panic("Error in goNum.PDEDiffEllipticalGeneral: k is not constant for nine-point stencil")
+				}
+				if ((i == 0) || (i == n) || (j == 0) || (j == m)) && !dirichlet(i, j) {
+					panic("Error in goNum.PDEDiffEllipticalGeneral: Non-Dirichlet boundary for nine-point stencil")
+				}
+			}
+		}
+		a := 1.0 / (hx * hx)
+		b := 1.0 / (hy * hy)
+		w := (hx*hx + hy*hy) / 12.0 * a * b
+		for j := 1; j < m; j++ {
+			for i := 1; i < n; i++ {
+				r := id(i, j)
+				for dj := -1; dj <= 1; dj++ {
+					for di := -1; di <= 1; di++ {
+						x, y := xs.Data[i+di], ys.Data[j+dj]
+						var lap, mass float64 //Laplace算子与M算子系数
+						switch {
+						case (di == 0) && (dj == 0):
+							lap = -2.0*a - 2.0*b + 4.0*w
+							mass = 2.0 / 3.0
+						case dj == 0:
+							lap = a - 2.0*w
+							mass = 1.0 / 12.0
+						case di == 0:
+							lap = b - 2.0*w
+							mass = 1.0 / 12.0
+						default:
+							lap = w
+						}
+						add(r, id(i+di, j+dj), -k0*lap+mass*funcc(x, y))
+						BA[r] += mass * funf(x, y)
+					}
+				}
+			}
+		}
+	} else {
+		for j := 0; j < m+1; j++ {
+			for i := 0; i < n+1; i++ {
+				if dirichlet(i, j) {
+					continue
+				}
+				r := id(i, j)
+				x, y := xs.Data[i], ys.Data[j]
+				//控制体尺寸
+				dxw, dxe, dys, dyn := 0.0, 0.0, 0.0, 0.0
+				if i > 0 {
+					dxw = x - xs.Data[i-1]
+				}
+				if i < n {
+					dxe = xs.Data[i+1] - x
+				}
+				if j > 0 {
+					dys = y - ys.Data[j-1]
+				}
+				if j < m {
+					dyn = ys.Data[j+1] - y
+				}
+				dx := (dxw + dxe) / 2.0
+				dy := (dys + dyn) / 2.0
+				//内部边通量
+				if i > 0 {
+					kf := funk(x-dxw/2.0, y) * dy / dxw
+					add(r, r, kf)
+					add(r, id(i-1, j), -kf)
+				}
+				if i < n {
+					kf := funk(x+dxe/2.0, y) * dy / dxe
+					add(r, r, kf)
+					add(r, id(i+1, j), -kf)
+				}
+				if j > 0 {
+					kf := funk(x, y-dys/2.0) * dx / dys
+					add(r, r, kf)
+					add(r, id(i, j-1), -kf)
+				}
+				if j < m {
+					kf := funk(x, y+dyn/2.0) * dx / dyn
+					add(r, r, kf)
+					add(r, id(i, j+1), -kf)
+				}
+				//边界通量
+				for _, e := range edges(i, j) {
+					p, q, g := bc(x, y, e)
+					l := dx
+					if e > 1 {
+						l = dy
+					}
+					add(r, r, p/q*l)
+					BA[r] += g / q * l
+				}
+				add(r, r, funcc(x, y)*dx*dy)
+				BA[r] += funf(x, y) * dx * dy
+			}
+		}
+	}
+
+	//求解带状方程组
+	tempp, temperr := bandSolve_PDEDiffEllipticalGeneral(AA, BA, bw)
+	if temperr != true {
+		panic("Error in goNum.PDEDiffEllipticalGeneral: Solve error")
+	}
+	for j := 0; j < m+1; j++ {
+		for i := 0; i < n+1; i++ {
+			sol.SetMatrix(j, i, tempp[id(i, j)])
+		}
+	}
+
+	err = true
+	return sol, err
+}
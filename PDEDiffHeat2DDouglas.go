@@ -0,0 +1,174 @@
+// PDEDiffHeat2DDouglas
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    求解二维热传导方程的Douglas交替方向隐式格式
+理论：
+    对于二维热传导方程：
+     du       d^2u   d^2u
+    ---- = A(------+------) + f(x, y, t)
+     dt       dx^2   dy^2
+
+    u(x, y, 0) = p(x, y)
+    四个边界面上u = g(x, y, t, face)，face：
+    0-x=x0，1-x=xL，2-y=y0，3-y=yL
+
+    x分为mx等份，y分为my等份，t分为n等份，rx = A*tau/hx^2，
+    ry = A*tau/hy^2，dx^2、dy^2为中心二阶差分算子，以Crank-Nicolson
+    格式为基础作近似因式分解：
+         rx                rx
+    (1 - --dx^2)u* = (1 + --dx^2 + ry*dy^2)un + tau*f_(n+1/2)
+         2                 2
+         ry                    ry
+    (1 - --dy^2)u_(n+1) = u* - --dy^2 un
+         2                     2
+    每步在各网格线上由追赶法(LEs_Chasing)求解三对角方程组。
+    中间层u*在x=x0、x=xL上的边界值为：
+                 ry
+    u* = g_(n+1) - --dy^2(g_(n+1) - gn)
+                 2
+
+    无条件稳定，时间与空间均为二阶精度，可推广至三维
+    (PDEDiffHeat3DDouglas)。
+
+    参考 J. Douglas. Alternating direction methods for three
+         space variables. Numer. Math., 1962, 4: 41-63.
+------------------------------------------------------
+输入   :
+    funp    初值函数p(x, y)
+    funf    源项f(x, y, t)
+    funb    边界函数g(x, y, t, face)
+    x0      求解范围，2x3，第一行为x、y、t的起点，第二行为终点
+    A       扩散系数
+    mx, my  x、y方向网格数量，不小于3
+    n       t方向网格数量
+输出   :
+    sol     t=T时刻的解矩阵，(mx+1)x(my+1)
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+// PDEDiffHeat2DDouglas 求解二维热传导方程的Douglas交替方向隐式格式
+func PDEDiffHeat2DDouglas(funp func(float64, float64) float64, funf func(float64, float64, float64) float64,
+	funb func(float64, float64, float64, int) float64, x0 Matrix, A float64, mx, my, n int) (Matrix, bool) {
+	/*
+		求解二维热传导方程的Douglas交替方向隐式格式
+		输入   :
+		    funp    初值函数p(x, y)
+		    funf    源项f(x, y, t)
+		    funb    边界函数g(x, y, t, face)
+		    x0      求解范围，2x3，第一行为x、y、t的起点，第二行为终点
+		    A       扩散系数
+		    mx, my  x、y方向网格数量，不小于3
+		    n       t方向网格数量
+		输出   :
+		    sol     t=T时刻的解矩阵，(mx+1)x(my+1)
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断网格数量
+	if (mx < 3) || (my < 3) || (n < 1) {
+		panic("Error in goNum.PDEDiffHeat2DDouglas: Grid numbers error")
+	}
+	//判断扩散系数
+	if A <= 0.0 {
+		panic("Error in goNum.PDEDiffHeat2DDouglas: A less than or equal to zero")
+	}
+
+	var err bool = false
+	xa, ya, t0 := x0.GetFromMatrix(0, 0), x0.GetFromMatrix(0, 1), x0.GetFromMatrix(0, 2)
+	hx := (x0.GetFromMatrix(1, 0) - xa) / float64(mx) //x方向步长
+	hy := (x0.GetFromMatrix(1, 1) - ya) / float64(my) //y方向步长
+	ht := (x0.GetFromMatrix(1, 2) - t0) / float64(n)  //t方向步长
+	rx := A * ht / (hx * hx)
+	ry := A * ht / (hy * hy)
+	xi := func(i int) float64 { return xa + float64(i)*hx }
+	yj := func(j int) float64 { return ya + float64(j)*hy }
+
+	//边界值
+	bound := func(u Matrix, t float64) {
+		for j := 0; j < my+1; j++ {
+			u.SetMatrix(0, j, funb(xi(0), yj(j), t, 0))
+			u.SetMatrix(mx, j, funb(xi(mx), yj(j), t, 1))
+		}
+		for i := 1; i < mx; i++ {
+			u.SetMatrix(i, 0, funb(xi(i), yj(0), t, 2))
+			u.SetMatrix(i, my, funb(xi(i), yj(my), t, 3))
+		}
+	}
+
+	//初值
+	sol := ZeroMatrix(mx+1, my+1)
+	for i := 0; i < mx+1; i++ {
+		for j := 0; j < my+1; j++ {
+			sol.SetMatrix(i, j, funp(xi(i), yj(j)))
+		}
+	}
+
+	Ax := lineMatrix_PDEDiffHeat2DPR(mx-1, rx)
+	Ay := lineMatrix_PDEDiffHeat2DPR(my-1, ry)
+	us := ZeroMatrix(mx+1, my+1) //中间层u*
+	un1 := ZeroMatrix(mx+1, my+1)
+	for k := 0; k < n; k++ {
+		tn := t0 + float64(k)*ht
+		th := tn + ht/2.0
+		bound(un1, tn+ht)
+		//u*的边界值
+		for _, i := range []int{0, mx} {
+			for j := 1; j < my; j++ {
+				gn := sol.GetFromMatrix(i, j)
+				g1 := un1.GetFromMatrix(i, j)
+				d2n := sol.GetFromMatrix(i, j-1) - 2.0*gn + sol.GetFromMatrix(i, j+1)
+				d21 := un1.GetFromMatrix(i, j-1) - 2.0*g1 + un1.GetFromMatrix(i, j+1)
+				us.SetMatrix(i, j, g1-ry*(d21-d2n)/2.0)
+			}
+		}
+
+		//x方向扫描
+		for j := 1; j < my; j++ {
+			Fi := ZeroMatrix(mx-1, 1)
+			for i := 1; i < mx; i++ {
+				d2x := sol.GetFromMatrix(i-1, j) - 2.0*sol.GetFromMatrix(i, j) + sol.GetFromMatrix(i+1, j)
+				d2y := sol.GetFromMatrix(i, j-1) - 2.0*sol.GetFromMatrix(i, j) + sol.GetFromMatrix(i, j+1)
+				Fi.Data[i-1] = sol.GetFromMatrix(i, j) + rx*d2x/2.0 + ry*d2y + ht*funf(xi(i), yj(j), th)
+			}
+			Fi.Data[0] += rx * us.GetFromMatrix(0, j) / 2.0
+			Fi.Data[mx-2] += rx * us.GetFromMatrix(mx, j) / 2.0
+			ui, errtemp := LEs_Chasing(Ax, Fi)
+			if errtemp != true {
+				panic("Error in goNum.PDEDiffHeat2DDouglas: Chasing solved error")
+			}
+			for i := 1; i < mx; i++ {
+				us.SetMatrix(i, j, ui.Data[i-1])
+			}
+		}
+
+		//y方向扫描
+		for i := 1; i < mx; i++ {
+			Fi := ZeroMatrix(my-1, 1)
+			for j := 1; j < my; j++ {
+				d2y := sol.GetFromMatrix(i, j-1) - 2.0*sol.GetFromMatrix(i, j) + sol.GetFromMatrix(i, j+1)
+				Fi.Data[j-1] = us.GetFromMatrix(i, j) - ry*d2y/2.0
+			}
+			Fi.Data[0] += ry * un1.GetFromMatrix(i, 0) / 2.0
+			Fi.Data[my-2] += ry * un1.GetFromMatrix(i, my) / 2.0
+			ui, errtemp := LEs_Chasing(Ay, Fi)
+			if errtemp != true {
+				panic("Error in goNum.PDEDiffHeat2DDouglas: Chasing solved error")
+			}
+			for j := 1; j < my; j++ {
+				un1.SetMatrix(i, j, ui.Data[j-1])
+			}
+		}
+		sol, un1 = un1, sol
+	}
+
+	err = true
+	return sol, err
+}
@@ -0,0 +1,275 @@
+// PDEDiffHeat3DDouglas_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    求解三维热传导方程的Douglas交替方向隐式格式
+理论：
+    对于三维热传导方程：
+     du       d^2u   d^2u   d^2u
+    ---- = A(------+------+------) + f(x, y, z, t)
+     dt       dx^2   dy^2   dz^2
+
+    u(x, y, z, 0) = p(x, y, z)
+    六个边界面上u = g(x, y, z, t, face)，face：
+    0-x=x0，1-x=xL，2-y=y0，3-y=yL，4-z=z0，5-z=zL
+
+    x、y、z分为mx、my、mz等份，t分为n等份，rx = A*tau/hx^2，
+    ry = A*tau/hy^2，rz = A*tau/hz^2，以Crank-Nicolson格式为基础
+    作近似因式分解：
+         rx                rx
+    (1 - --dx^2)u* = (1 + --dx^2 + ry*dy^2 + rz*dz^2)un
+         2                 2
+                     + tau*f_(n+1/2)
+         ry                ry
+    (1 - --dy^2)u** = u* - --dy^2 un
+         2                 2
+         rz                     rz
+    (1 - --dz^2)u_(n+1) = u** - --dz^2 un
+         2                      2
+    每步在各网格线上由追赶法(LEs_Chasing)求解三对角方程组。
+    中间层的边界值由后续各式反推：
+                   rz                       ry
+    u** = g_(n+1) - --dz^2(g_(n+1) - gn), u* = u** - --dy^2(u** - gn)
+                   2                        2
+
+    无条件稳定，时间与空间均为二阶精度。
+
+    参考 J. Douglas. Alternating direction methods for three
+         space variables. Numer. Math., 1962, 4: 41-63.
+------------------------------------------------------
+输入   :
+    funp    初值函数p(x, y, z)
+    funf    源项f(x, y, z, t)
+    funb    边界函数g(x, y, z, t, face)
+    x0      求解范围，2x4，第一行为x、y、z、t的起点，第二行为终点
+    A       扩散系数
+    mx, my, mz  x、y、z方向网格数量，不小于3
+    n       t方向网格数量
+输出   :
+    sol     t=T时刻的解矩阵，(mx+1)x((my+1)*(mz+1))，
+            u(xi, yj, zk)位于第i行第j*(mz+1)+k列
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// PDEDiffHeat3DDouglas 求解三维热传导方程的Douglas交替方向隐式格式
+func PDEDiffHeat3DDouglas(funp func(float64, float64, float64) float64,
+	funf func(float64, float64, float64, float64) float64,
+	funb func(float64, float64, float64, float64, int) float64,
+	x0 goNum.Matrix, A float64, mx, my, mz, n int) (goNum.Matrix, bool) {
+	/*
+		求解三维热传导方程的Douglas交替方向隐式格式
+		输入   :
+		    funp    初值函数p(x, y, z)
+		    funf    源项f(x, y, z, t)
+		    funb    边界函数g(x, y, z, t, face)
+		    x0      求解范围，2x4，第一行为x、y、z、t的起点，第二行为终点
+		    A       扩散系数
+		    mx, my, mz  x、y、z方向网格数量，不小于3
+		    n       t方向网格数量
+		输出   :
+		    sol     t=T时刻的解矩阵，(mx+1)x((my+1)*(mz+1))，
+		            u(xi, yj, zk)位于第i行第j*(mz+1)+k列
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断网格数量
+	if (mx < 3) || (my < 3) || (mz < 3) || (n < 1) {
+		panic("Error in goNum.PDEDiffHeat3DDouglas: Grid numbers error")
+	}
+	//判断扩散系数
+	if A <= 0.0 {
+		panic("Error in goNum.PDEDiffHeat3DDouglas: A less than or equal to zero")
+	}
+
+	var err bool = false
+	xa, ya, za := x0.GetFromMatrix(0, 0), x0.GetFromMatrix(0, 1), x0.GetFromMatrix(0, 2)
+	t0 := x0.GetFromMatrix(0, 3)
+	hx := (x0.GetFromMatrix(1, 0) - xa) / float64(mx) //x方向步长
+	hy := (x0.GetFromMatrix(1, 1) - ya) / float64(my) //y方向步长
+	hz := (x0.GetFromMatrix(1, 2) - za) / float64(mz) //z方向步长
+	ht := (x0.GetFromMatrix(1, 3) - t0) / float64(n)  //t方向步长
+	rx := A * ht / (hx * hx)
+	ry := A * ht / (hy * hy)
+	rz := A * ht / (hz * hz)
+	xi := func(i int) float64 { return xa + float64(i)*hx }
+	yj := func(j int) float64 { return ya + float64(j)*hy }
+	zk := func(k int) float64 { return za + float64(k)*hz }
+	//u(i, j, k)在Data中的位置
+	id := func(i, j, k int) int { return (i*(my+1)+j)*(mz+1) + k }
+	//中心二阶差分，方向0-x，1-y，2-z
+	d2 := func(u goNum.Matrix, i, j, k, dir int) float64 {
+		switch dir {
+		case 0:
+			return u.Data[id(i-1, j, k)] - 2.0*u.Data[id(i, j, k)] + u.Data[id(i+1, j, k)]
+		case 1:
+			return u.Data[id(i, j-1, k)] - 2.0*u.Data[id(i, j, k)] + u.Data[id(i, j+1, k)]
+		default:
+			return u.Data[id(i, j, k-1)] - 2.0*u.Data[id(i, j, k)] + u.Data[id(i, j, k+1)]
+		}
+	}
+
+	//边界值
+	bound := func(u goNum.Matrix, t float64) {
+		for i := 0; i < mx+1; i++ {
+			for j := 0; j < my+1; j++ {
+				for k := 0; k < mz+1; k++ {
+					switch {
+					case i == 0:
+						u.Data[id(i, j, k)] = funb(xi(i), yj(j), zk(k), t, 0)
+					case i == mx:
+						u.Data[id(i, j, k)] = funb(xi(i), yj(j), zk(k), t, 1)
+					case j == 0:
+						u.Data[id(i, j, k)] = funb(xi(i), yj(j), zk(k), t, 2)
+					case j == my:
+						u.Data[id(i, j, k)] = funb(xi(i), yj(j), zk(k), t, 3)
+					case k == 0:
+						u.Data[id(i, j, k)] = funb(xi(i), yj(j), zk(k), t, 4)
+					case k == mz:
+						u.Data[id(i, j, k)] = funb(xi(i), yj(j), zk(k), t, 5)
+					}
+				}
+			}
+		}
+	}
+
+	//初值
+	sol := goNum.ZeroMatrix(mx+1, (my+1)*(mz+1))
+	for i := 0; i < mx+1; i++ {
+		for j := 0; j < my+1; j++ {
+			for k := 0; k < mz+1; k++ {
+				sol.Data[id(i, j, k)] = funp(xi(i), yj(j), zk(k))
+			}
+		}
+	}
+
+	Ax := lineMatrix_PDEDiffHeat2DPR(mx-1, rx)
+	Ay := lineMatrix_PDEDiffHeat2DPR(my-1, ry)
+	Az := lineMatrix_PDEDiffHeat2DPR(mz-1, rz)
+	us := goNum.ZeroMatrix(mx+1, (my+1)*(mz+1))  //u*
+	uss := goNum.ZeroMatrix(mx+1, (my+1)*(mz+1)) //u**
+	un1 := goNum.ZeroMatrix(mx+1, (my+1)*(mz+1))
+	for s := 0; s < n; s++ {
+		tn := t0 + float64(s)*ht
+		th := tn + ht/2.0
+		bound(un1, tn+ht)
+		//u**在x、y边界面上的值
+		for i := 0; i < mx+1; i++ {
+			for j := 0; j < my+1; j++ {
+				if (i != 0) && (i != mx) && (j != 0) && (j != my) {
+					continue
+				}
+				for k := 0; k < mz+1; k++ {
+					p := id(i, j, k)
+					uss.Data[p] = un1.Data[p]
+					if (k != 0) && (k != mz) {
+						uss.Data[p] -= rz * (d2(un1, i, j, k, 2) - d2(sol, i, j, k, 2)) / 2.0
+					}
+				}
+			}
+		}
+		//u*在x边界面上的值
+		for _, i := range []int{0, mx} {
+			for j := 1; j < my; j++ {
+				for k := 1; k < mz; k++ {
+					p := id(i, j, k)
+					us.Data[p] = uss.Data[p] - ry*(d2(uss, i, j, k, 1)-d2(sol, i, j, k, 1))/2.0
+				}
+			}
+		}
+
+		//x方向扫描
+		for j := 1; j < my; j++ {
+			for k := 1; k < mz; k++ {
+				Fi := goNum.ZeroMatrix(mx-1, 1)
+				for i := 1; i < mx; i++ {
+					Fi.Data[i-1] = sol.Data[id(i, j, k)] + rx*d2(sol, i, j, k, 0)/2.0 +
+						ry*d2(sol, i, j, k, 1) + rz*d2(sol, i, j, k, 2) + ht*funf(xi(i), yj(j), zk(k), th)
+				}
+				Fi.Data[0] += rx * us.Data[id(0, j, k)] / 2.0
+				Fi.Data[mx-2] += rx * us.Data[id(mx, j, k)] / 2.0
+				ui, errtemp := goNum.LEs_Chasing(Ax, Fi)
+				if errtemp != true {
+					panic("Error in goNum.PDEDiffHeat3DDouglas: Chasing solved error")
+				}
+				for i := 1; i < mx; i++ {
+					us.Data[id(i, j, k)] = ui.Data[i-1]
+				}
+			}
+		}
+
+		//y方向扫描
+		for i := 1; i < mx; i++ {
+			for k := 1; k < mz; k++ {
+				Fi := goNum.ZeroMatrix(my-1, 1)
+				for j := 1; j < my; j++ {
+					Fi.Data[j-1] = us.Data[id(i, j, k)] - ry*d2(sol, i, j, k, 1)/2.0
+				}
+				Fi.Data[0] += ry * uss.Data[id(i, 0, k)] / 2.0
+				Fi.Data[my-2] += ry * uss.Data[id(i, my, k)] / 2.0
+				ui, errtemp := goNum.LEs_Chasing(Ay, Fi)
+				if errtemp != true {
+					panic("Error in goNum.PDEDiffHeat3DDouglas: Chasing solved error")
+				}
+				for j := 1; j < my; j++ {
+					uss.Data[id(i, j, k)] = ui.Data[j-1]
+				}
+			}
+		}
+
+		//z方向扫描
+		for i := 1; i < mx; i++ {
+			for j := 1; j < my; j++ {
+				Fi := goNum.ZeroMatrix(mz-1, 1)
+				for k := 1; k < mz; k++ {
+					Fi.Data[k-1] = uss.Data[id(i, j, k)] - rz*d2(sol, i, j, k, 2)/2.0
+				}
+				Fi.Data[0] += rz * un1.Data[id(i, j, 0)] / 2.0
+				Fi.Data[mz-2] += rz * un1.Data[id(i, j, mz)] / 2.0
+				ui, errtemp := goNum.LEs_Chasing(Az, Fi)
+				if errtemp != true {
+					panic("Error in goNum.PDEDiffHeat3DDouglas: Chasing solved error")
+				}
+				for k := 1; k < mz; k++ {
+					un1.Data[id(i, j, k)] = ui.Data[k-1]
+				}
+			}
+		}
+		sol, un1 = un1, sol
+	}
+
+	err = true
+	return sol, err
+}
+
+func fun71_p(x, y, z float64) float64 {
+	return math.Sin(x + 2.0*y + z)
+}
+
+func fun71_f(x, y, z, t float64) float64 {
+	return 2.0 * math.Exp(-t) * math.Sin(x+2.0*y+z)
+}
+
+func fun71_b(x, y, z, t float64, face int) float64 {
+	return math.Exp(-t) * math.Sin(x+2.0*y+z)
+}
+
+func BenchmarkPDEDiffHeat3DDouglas(b *testing.B) {
+	x71 := goNum.NewMatrix(2, 4, []float64{0.0, 0.0, 0.0, 0.0, 1.0, 1.0, 1.0, 1.0})
+	for i := 0; i < b.N; i++ {
+		goNum.PDEDiffHeat3DDouglas(fun71_p, fun71_f, fun71_b, x71, 0.5, 8, 8, 8, 8)
+	}
+}
@@ -4,6 +4,7 @@
 作者   : Black Ghost
 日期   : 2018-12-17
 版本   : 0.0.0
+         0.0.1 2026-10-19 第一层改为二阶精度，稳定性判断给出明确的CFL条件错误信息
 ------------------------------------------------------
     求解双曲型偏微分方程的差分解法（第一种差分格式）
 理论：
@@ -23,7 +24,8 @@
 
     初值需要计算第零层和第一层、左右边界
     第零层：u_(i,0) = phi(i*hx), i=1,2,...,m-1
-    第一层：u_(i,1) = u_(i,0) + ht*psi(i*hx)
+    第一层：u_(i,1) = u_(i,0) + ht*psi(i*hx) + B*ht^2/2 +
+                     l*(u_(i+1,0)-2u_(i,0)+u_(i-1,0))/2
     左边界：u_(0,j) = u1(j*ht)
     右边界：u_(m,j) = u2(j*ht), j=0,1,2,...,n
 
@@ -81,11 +83,14 @@ func PDEDiffHyperbolic1(funphi, funpsi, funu1, funu2 func(float64) float64,
 	//lambda及稳定性判断
 	l := A * ht * ht / (hx * hx)
 	if l > 1 {
-		panic("Error in goNum.PDEDiffHyperbolic1: lambda greater than one")
+		panic("Error in goNum.PDEDiffHyperbolic1: CFL condition violated, A*ht^2/hx^2 greater than one")
 	}
 	//3. 计算t第一层上的值u_(i,1) i=,1,...,m-1
 	for i := 1; i < m; i++ {
-		sol.SetMatrix(i, 1, sol.GetFromMatrix(i, 0)+ht*funpsi(x0.GetFromMatrix(0, 0)+float64(i)*hx))
+		temp0 := sol.GetFromMatrix(i+1, 0) - 2.0*sol.GetFromMatrix(i, 0) + sol.GetFromMatrix(i-1, 0)
+		temp0 = l*temp0/2.0 + B*ht*ht/2.0
+		temp0 += sol.GetFromMatrix(i, 0) + ht*funpsi(x0.GetFromMatrix(0, 0)+float64(i)*hx)
+		sol.SetMatrix(i, 1, temp0)
 	}
 	//4. 2～n层
 	for j := 2; j < n+1; j++ {
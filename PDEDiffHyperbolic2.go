@@ -4,6 +4,7 @@
 作者   : Black Ghost
 日期   : 2018-12-17
 版本   : 0.0.0
+         0.0.1 2026-10-19 修正第一层计算公式，稳定性判断给出明确的CFL条件错误信息
 ------------------------------------------------------
     求解双曲型偏微分方程的差分解法（第二种差分格式，t=0时微分方程须成立）
 理论：
@@ -62,7 +63,7 @@ func PDEDiffHyperbolic2(funphi, funpsi, funu1, funu2 func(float64) float64,
 	*/
 	//判断网格数量
 	if (m < 1) || (n < 1) {
-		panic("Error in goNum.PDEDiffHyperbolic1: Grid numbers error")
+		panic("Error in goNum.PDEDiffHyperbolic2: Grid numbers error")
 	}
 
 	var err bool = false
@@ -82,13 +83,14 @@ func PDEDiffHyperbolic2(funphi, funpsi, funu1, funu2 func(float64) float64,
 	//lambda及稳定性判断
 	l := A * ht * ht / (hx * hx)
 	if l > 1 {
-		panic("Error in goNum.PDEDiffHyperbolic1: lambda greater than one")
+		panic("Error in goNum.PDEDiffHyperbolic2: CFL condition violated, A*ht^2/hx^2 greater than one")
 	}
 	//3. 计算t第一层上的值u_(i,1) i=,1,...,m-1
 	for i := 1; i < m; i++ {
-		temp0 := sol.GetFromMatrix(i, 0) + ht*funpsi(x0.GetFromMatrix(0, 0)+float64(i)*hx)
-		temp0 += sol.GetFromMatrix(i+1, 0) - 2.0*sol.GetFromMatrix(i, 0) + sol.GetFromMatrix(i-1, 0)
-		sol.SetMatrix(i, 1, l*temp0/2.0+B*ht*ht/2.0)
+		temp0 := sol.GetFromMatrix(i+1, 0) - 2.0*sol.GetFromMatrix(i, 0) + sol.GetFromMatrix(i-1, 0)
+		temp0 = l*temp0/2.0 + B*ht*ht/2.0
+		temp0 += sol.GetFromMatrix(i, 0) + ht*funpsi(x0.GetFromMatrix(0, 0)+float64(i)*hx)
+		sol.SetMatrix(i, 1, temp0)
 	}
 	//4. 2～n层
 	for j := 2; j < n+1; j++ {
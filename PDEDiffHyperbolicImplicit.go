@@ -0,0 +1,208 @@
+// PDEDiffHyperbolicImplicit
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    求解双曲型偏微分方程的隐式差分解法（theta格式，可含吸收边界）
+理论：
+    对于双曲型偏微分方程：
+     d^2u       d^2u
+    ------ = A ------ + B
+     dt^2       dx^2
+
+    u(x, 0) = phi(x), (du/dt)_(t=0) = psi(x)
+    u(0, t) = u1(t), u(L, t) = u2(t)
+
+    0 < x < L, 0 < t < T
+
+    则差分格式为，x分为m等份，t分为n等份，l = A*ht^2/hx^2，
+    d^2为x方向中心二阶差分算子：
+    u_(j+1) - 2uj + u_(j-1) = l*[theta*d^2u_(j+1) + (1-2theta)*d^2uj
+                              + theta*d^2u_(j-1)] + B*ht^2
+    每层由追赶法(LEs_Chasing)求解三对角方程组。
+    各theta均为二阶精度，theta >= 1/4时无条件稳定；theta < 1/4
+    时须满足CFL条件 l <= 1/(1-4theta)，theta = 0即为显式格式
+    PDEDiffHyperbolic1。
+
+    第一层由Taylor展开取二阶精度：
+    u_(i,1) = u_(i,0) + ht*psi(xi) + l*d^2u_(i,0)/2 + B*ht^2/2
+
+    吸收（无反射）边界：funu1或funu2为nil时该端取一阶
+    Engquist-Majda边界条件，c = sqrt(A)：
+    左端 du/dt - c*du/dx = 0，右端 du/dt + c*du/dx = 0
+    以(x_(1/2), t_(j+1/2))为中心的盒式格式离散，r = c*ht/hx：
+    (1+r)u_(0,j+1) + (1-r)u_(1,j+1) = (1-r)u_(0,j) + (1+r)u_(1,j)
+    右端同理。吸收边界要求B = 0。
+
+    参考 A. Engquist and A. Majda. Absorbing boundary
+         conditions for the numerical simulation of waves.
+         Math. Comp., 1977, 31(139): 629-651.
+------------------------------------------------------
+输入   :
+    funphi, funpsi, funu1, funu2   边界函数，funu1、funu2为nil
+            时该端取吸收边界
+    x0      求解范围，2x2
+    A, B    常系数
+    theta   隐式权重，0～1/2
+    m, n    网格数量
+输出   :
+    sol     解矩阵
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+// PDEDiffHyperbolicImplicit 求解双曲型偏微分方程的隐式差分解法（theta格式，可含吸收边界）
+func PDEDiffHyperbolicImplicit(funphi, funpsi, funu1, funu2 func(float64) float64,
+	x0 Matrix, A, B, theta float64, m, n int) (Matrix, bool) {
+	/*
+		求解双曲型偏微分方程的隐式差分解法（theta格式，可含吸收边界）
+		输入   :
+		    funphi, funpsi, funu1, funu2   边界函数，funu1、funu2为nil
+		            时该端取吸收边界
+		    x0      求解范围，2x2
+		    A, B    常系数
+		    theta   隐式权重，0～1/2
+		    m, n    网格数量
+		输出   :
+		    sol     解矩阵
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断网格数量
+	if (m < 3) || (n < 1) {
+		panic("Error in goNum.PDEDiffHyperbolicImplicit: Grid numbers error")
+	}
+	//判断系数
+	if A <= 0.0 {
+		panic("Error in goNum.PDEDiffHyperbolicImplicit: A less than or equal to zero")
+	}
+	if (theta < 0.0) || (theta > 0.5) {
+		panic("Error in goNum.PDEDiffHyperbolicImplicit: theta is not in [0, 1/2]")
+	}
+
+	var err bool = false
+	sol := ZeroMatrix(m+1, n+1)
+	xa := x0.GetFromMatrix(0, 0)
+	t0 := x0.GetFromMatrix(0, 1)
+	hx := (x0.GetFromMatrix(1, 0) - xa) / float64(m) //x方向步长
+	ht := (x0.GetFromMatrix(1, 1) - t0) / float64(n) //t方向步长
+	absl := funu1 == nil
+	absr := funu2 == nil
+	if (absl || absr) && (B != 0.0) {
+		panic("Error in goNum.PDEDiffHyperbolicImplicit: B is not zero with absorbing boundary")
+	}
+
+	//lambda及CFL条件判断
+	l := A * ht * ht / (hx * hx)
+	if (theta < 0.25) && (l*(1.0-4.0*theta) > 1.0) {
+		panic("Error in goNum.PDEDiffHyperbolicImplicit: CFL condition violated, A*ht^2/hx^2 greater than 1/(1-4*theta)")
+	}
+	r := math.Sqrt(A) * ht / hx
+
+	//1. 计算t第零层上的值
+	for i := 0; i < m+1; i++ {
+		sol.SetMatrix(i, 0, funphi(xa+float64(i)*hx))
+	}
+	//2. 计算x左右边界上的节点u_(0,j)和u_(m,j) j=0,1,2,...,n
+	for j := 0; j < n+1; j++ {
+		if !absl {
+			sol.SetMatrix(0, j, funu1(t0+float64(j)*ht)) //左边界
+		}
+		if !absr {
+			sol.SetMatrix(m, j, funu2(t0+float64(j)*ht)) //右边界
+		}
+	}
+	//3. 计算t第一层上的值
+	for i := 1; i < m; i++ {
+		temp0 := sol.GetFromMatrix(i+1, 0) - 2.0*sol.GetFromMatrix(i, 0) + sol.GetFromMatrix(i-1, 0)
+		temp0 = l*temp0/2.0 + B*ht*ht/2.0
+		temp0 += sol.GetFromMatrix(i, 0) + ht*funpsi(xa+float64(i)*hx)
+		sol.SetMatrix(i, 1, temp0)
+	}
+	if absl {
+		temp0 := (1.0-r)*sol.GetFromMatrix(0, 0) + (1.0+r)*sol.GetFromMatrix(1, 0) - (1.0-r)*sol.GetFromMatrix(1, 1)
+		sol.SetMatrix(0, 1, temp0/(1.0+r))
+	}
+	if absr {
+		temp0 := (1.0-r)*sol.GetFromMatrix(m, 0) + (1.0+r)*sol.GetFromMatrix(m-1, 0) - (1.0-r)*sol.GetFromMatrix(m-1, 1)
+		sol.SetMatrix(m, 1, temp0/(1.0+r))
+	}
+
+	//未知量节点范围，吸收边界节点也作为未知量
+	i0, i1 := 1, m-1
+	if absl {
+		i0 = 0
+	}
+	if absr {
+		i1 = m
+	}
+	nu := i1 - i0 + 1
+	//系数矩阵
+	AA := ZeroMatrix(nu, nu)
+	for i := i0; i < i1+1; i++ {
+		k := i - i0
+		switch i {
+		case 0:
+			AA.SetMatrix(k, k, 1.0+r)
+			AA.SetMatrix(k, k+1, 1.0-r)
+		case m:
+			AA.SetMatrix(k, k, 1.0+r)
+			AA.SetMatrix(k, k-1, 1.0-r)
+		default:
+			AA.SetMatrix(k, k, 1.0+2.0*theta*l)
+			if k > 0 {
+				AA.SetMatrix(k, k-1, -theta*l)
+			}
+			if k < nu-1 {
+				AA.SetMatrix(k, k+1, -theta*l)
+			}
+		}
+	}
+
+	//4. 2～n层
+	d2 := func(i, j int) float64 {
+		return sol.GetFromMatrix(i+1, j) - 2.0*sol.GetFromMatrix(i, j) + sol.GetFromMatrix(i-1, j)
+	}
+	for j := 2; j < n+1; j++ {
+		Fi := ZeroMatrix(nu, 1)
+		for i := i0; i < i1+1; i++ {
+			k := i - i0
+			switch i {
+			case 0:
+				Fi.Data[k] = (1.0-r)*sol.GetFromMatrix(0, j-1) + (1.0+r)*sol.GetFromMatrix(1, j-1)
+			case m:
+				Fi.Data[k] = (1.0-r)*sol.GetFromMatrix(m, j-1) + (1.0+r)*sol.GetFromMatrix(m-1, j-1)
+			default:
+				temp0 := 2.0*sol.GetFromMatrix(i, j-1) - sol.GetFromMatrix(i, j-2)
+				temp0 += l * ((1.0-2.0*theta)*d2(i, j-1) + theta*d2(i, j-2))
+				Fi.Data[k] = temp0 + B*ht*ht
+			}
+		}
+		//Dirichlet边界贡献
+		if !absl {
+			Fi.Data[0] += theta * l * sol.GetFromMatrix(0, j)
+		}
+		if !absr {
+			Fi.Data[nu-1] += theta * l * sol.GetFromMatrix(m, j)
+		}
+		ui, errtemp := LEs_Chasing(AA, Fi)
+		if errtemp != true {
+			panic("Error in goNum.PDEDiffHyperbolicImplicit: Chasing solved error")
+		}
+		for i := i0; i < i1+1; i++ {
+			sol.SetMatrix(i, j, ui.Data[i-i0])
+		}
+	}
+
+	err = true
+	return sol, err
+}
@@ -0,0 +1,197 @@
+// PDEDiffParabolicMOL
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    求解非线性抛物型偏微分方程的直线法
+理论：
+    对于抛物型偏微分方程：
+     du     d        du
+    ---- = ---(a(x,t,u)---) + f(x,t,u)
+     dt     dx       dx
+
+    u(x, 0) = p(x)
+    左右边界：pb(t)*u + qb(t)*du/dx = gb(t)
+    qb = 0为Dirichlet边界，pb = 0为Neumann边界，否则为Robin边界
+
+    0 < x < L, 0 < t < T
+
+    x分为m等份，空间以守恒型中心差分离散：
+     dui     a_(i+1/2)(u_(i+1)-ui) - a_(i-1/2)(ui-u_(i-1))
+    ----- = ------------------------------------------- + f(xi,t,ui)
+     dt                        h^2
+    a_(i+1/2) = a(x_(i+1/2), t, (ui+u_(i+1))/2)
+
+    Neumann与Robin边界节点取半个控制体：
+     du0     2  a_(1/2)(u1-u0)                g0-p0*u0
+    ----- = ---(---------------- - a(x0,t,u0)----------) + f(x0,t,u0)
+     dt      h         h                        q0
+    右边界同理。Dirichlet边界节点不作为未知量，由边界条件直接
+    给出。
+
+    所得常微分方程组由指定的ODE求解器（如RK44、RK22、ODEAdamsEX、
+    ODEAdamsBashforthMoultonSys等）在t方向积分，显式求解器须满足
+    ht <= h^2/(2*max(a))。
+
+    参考 W.E. Schiesser. The Numerical Method of Lines:
+         Integration of Partial Differential Equations.
+         Academic Press, 1991.
+------------------------------------------------------
+输入   :
+    funa    扩散系数a(x, t, u)
+    funf    源项f(x, t, u)
+    funp    初值函数p(x)
+    bcl     左边界函数(t)，返回(pb, qb, gb)
+    bcr     右边界函数(t)，返回(pb, qb, gb)
+    x0      求解范围，2x2
+    m, n    网格数量
+    solver  ODE求解器(方程, 初值向量, 终止t, 方程个数, 步数)
+输出   :
+    sol     解矩阵，(m+1)x(n+1)
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+// PDEDiffParabolicMOL 求解非线性抛物型偏微分方程的直线法
+func PDEDiffParabolicMOL(funa, funf func(float64, float64, float64) float64,
+	funp func(float64) float64, bcl, bcr func(float64) (float64, float64, float64),
+	x0 Matrix, m, n int,
+	solver func(func(Matrix, int) float64, Matrix, float64, int, int) (Matrix, bool)) (Matrix, bool) {
+	/*
+		求解非线性抛物型偏微分方程的直线法
+		输入   :
+		    funa    扩散系数a(x, t, u)
+		    funf    源项f(x, t, u)
+		    funp    初值函数p(x)
+		    bcl     左边界函数(t)，返回(pb, qb, gb)
+		    bcr     右边界函数(t)，返回(pb, qb, gb)
+		    x0      求解范围，2x2
+		    m, n    网格数量
+		    solver  ODE求解器(方程, 初值向量, 终止t, 方程个数, 步数)
+		输出   :
+		    sol     解矩阵，(m+1)x(n+1)
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断网格数量
+	if (m < 2) || (n < 1) {
+		panic("Error in goNum.PDEDiffParabolicMOL: Grid numbers error")
+	}
+
+	xa := x0.GetFromMatrix(0, 0)
+	t0 := x0.GetFromMatrix(0, 1)
+	T := x0.GetFromMatrix(1, 1)
+	hx := (x0.GetFromMatrix(1, 0) - xa) / float64(m) //x方向步长
+
+	//边界类型，Dirichlet边界节点不作为未知量
+	pl, ql, _ := bcl(t0)
+	pr, qr, _ := bcr(t0)
+	if ((pl == 0.0) && (ql == 0.0)) || ((pr == 0.0) && (qr == 0.0)) {
+		panic("Error in goNum.PDEDiffParabolicMOL: Boundary condition error")
+	}
+	dirl := ql == 0.0
+	dirr := qr == 0.0
+	i0, i1 := 0, m //未知量节点范围
+	if dirl {
+		i0 = 1
+	}
+	if dirr {
+		i1 = m - 1
+	}
+	fn := i1 - i0 + 1
+
+	//由未知量组装全部节点值
+	u := make([]float64, m+1)
+	assemble := func(xy Matrix) {
+		t := xy.Data[0]
+		for i := i0; i < i1+1; i++ {
+			u[i] = xy.Data[i-i0+1]
+		}
+		if dirl {
+			p, _, g := bcl(t)
+			u[0] = g / p
+		}
+		if dirr {
+			p, _, g := bcr(t)
+			u[m] = g / p
+		}
+	}
+
+	//右端项，对同一计算变量值向量缓存
+	last := make([]float64, fn+1)
+	dudt := make([]float64, fn)
+	cached := false
+	rhs := func(xy Matrix) {
+		if cached {
+			same := true
+			for i := 0; i < fn+1; i++ {
+				if last[i] != xy.Data[i] {
+					same = false
+					break
+				}
+			}
+			if same {
+				return
+			}
+		}
+		copy(last, xy.Data)
+		cached = true
+		assemble(xy)
+		t := xy.Data[0]
+		//半节点通量
+		flux := make([]float64, m)
+		for i := 0; i < m; i++ {
+			xh := xa + (float64(i)+0.5)*hx
+			flux[i] = funa(xh, t, (u[i]+u[i+1])/2.0) * (u[i+1] - u[i]) / hx
+		}
+		for i := i0; i < i1+1; i++ {
+			xi := xa + float64(i)*hx
+			switch i {
+			case 0:
+				p, q, g := bcl(t)
+				fb := funa(xi, t, u[0]) * (g - p*u[0]) / q
+				dudt[i-i0] = 2.0*(flux[0]-fb)/hx + funf(xi, t, u[0])
+			case m:
+				p, q, g := bcr(t)
+				fb := funa(xi, t, u[m]) * (g - p*u[m]) / q
+				dudt[i-i0] = 2.0*(fb-flux[m-1])/hx + funf(xi, t, u[m])
+			default:
+				dudt[i-i0] = (flux[i]-flux[i-1])/hx + funf(xi, t, u[i])
+			}
+		}
+	}
+	fun := func(xy Matrix, i int) float64 {
+		rhs(xy)
+		return dudt[i]
+	}
+
+	//初值
+	xy0 := ZeroMatrix(fn+1, 1)
+	xy0.Data[0] = t0
+	for i := i0; i < i1+1; i++ {
+		xy0.Data[i-i0+1] = funp(xa + float64(i)*hx)
+	}
+
+	solt, err := solver(fun, xy0, T, fn, n)
+	if solt.Columns != n+1 {
+		panic("Error in goNum.PDEDiffParabolicMOL: Steps of solver are not equal to n")
+	}
+
+	//解矩阵
+	sol := ZeroMatrix(m+1, n+1)
+	for j := 0; j < n+1; j++ {
+		for i := 0; i < fn+1; i++ {
+			xy0.Data[i] = solt.GetFromMatrix(i, j)
+		}
+		assemble(xy0)
+		for i := 0; i < m+1; i++ {
+			sol.SetMatrix(i, j, u[i])
+		}
+	}
+	return sol, err
+}
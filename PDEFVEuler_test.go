@@ -0,0 +1,109 @@
+// PDEFVEuler_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    一维Euler方程组的通量、特征速度与Roe特征结构
+理论：
+    一维Euler方程组（理想气体）：
+     d  | rho  |    d  |  rho*u    |
+    --- | rho*u| + --- | rho*u^2+p | = 0
+     dt |  E   |    dx |  (E+p)u   |
+
+    p = (gamma-1)(E - rho*u^2/2)，c = sqrt(gamma*p/rho)
+    特征值：u - c, u, u + c
+
+    Roe平均：
+         sqrt(rhoL)uL + sqrt(rhoR)uR        E + p
+    u~ = --------------------------- ，H = -------，H~同理
+           sqrt(rhoL) + sqrt(rhoR)           rho
+    c~ = sqrt((gamma-1)(H~ - u~^2/2))
+    特征值lambda = u~ - c~, u~, u~ + c~
+    右特征向量：r1 = [1, u~ - c~, H~ - u~c~]'
+               r2 = [1, u~, u~^2/2]'
+               r3 = [1, u~ + c~, H~ + u~c~]'
+
+    与PDEFVFluxLaxFriedrichs、PDEFVFluxLaxWendroff、PDEFVFluxRoe、
+    PDEFVFluxHLL及PDEFVSolve配合使用，U = [rho, rho*u, E]'。
+
+    参考 E.F. Toro. Riemann Solvers and Numerical Methods for
+         Fluid Dynamics, 3rd ed. Springer, 2009. ss 3.1, 11.2.
+------------------------------------------------------
+输入   :
+    gamma   比热比
+输出   :
+    funf    通量函数f(U)
+    funs    特征速度范围(U)，返回(最小特征值, 最大特征值)
+    funroe  Roe矩阵特征结构(UL, UR)，返回特征值与右特征向量矩阵
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// PDEFVEuler 一维Euler方程组的通量、特征速度与Roe特征结构
+func PDEFVEuler(gamma float64) (func(goNum.Matrix) goNum.Matrix, func(goNum.Matrix) (float64, float64),
+	func(goNum.Matrix, goNum.Matrix) (goNum.Matrix, goNum.Matrix)) {
+	/*
+		一维Euler方程组的通量、特征速度与Roe特征结构
+		输入   :
+		    gamma   比热比
+		输出   :
+		    funf    通量函数f(U)
+		    funs    特征速度范围(U)，返回(最小特征值, 最大特征值)
+		    funroe  Roe矩阵特征结构(UL, UR)，返回特征值与右特征向量矩阵
+	*/
+	//判断gamma
+	if gamma <= 1.0 {
+		panic("Error in goNum.PDEFVEuler: gamma less than or equal to one")
+	}
+
+	//压强
+	pres := func(U goNum.Matrix) float64 {
+		rho, m, E := U.Data[0], U.Data[1], U.Data[2]
+		return (gamma - 1.0) * (E - m*m/(2.0*rho))
+	}
+	funf := func(U goNum.Matrix) goNum.Matrix {
+		rho, m, E := U.Data[0], U.Data[1], U.Data[2]
+		u := m / rho
+		p := pres(U)
+		return goNum.NewMatrix(3, 1, []float64{m, m*u + p, (E + p) * u})
+	}
+	funs := func(U goNum.Matrix) (float64, float64) {
+		rho := U.Data[0]
+		u := U.Data[1] / rho
+		c := math.Sqrt(gamma * math.Max(pres(U), 0.0) / rho)
+		return u - c, u + c
+	}
+	funroe := func(UL, UR goNum.Matrix) (goNum.Matrix, goNum.Matrix) {
+		sL, sR := math.Sqrt(UL.Data[0]), math.Sqrt(UR.Data[0])
+		u := (UL.Data[1]/sL + UR.Data[1]/sR) / (sL + sR)
+		HL := (UL.Data[2] + pres(UL)) / UL.Data[0]
+		HR := (UR.Data[2] + pres(UR)) / UR.Data[0]
+		H := (sL*HL + sR*HR) / (sL + sR)
+		c := math.Sqrt((gamma - 1.0) * math.Max(H-u*u/2.0, 0.0))
+		lambda := goNum.NewMatrix(3, 1, []float64{u - c, u, u + c})
+		R := goNum.NewMatrix(3, 3, []float64{
+			1.0, 1.0, 1.0,
+			u - c, u, u + c,
+			H - u*c, u * u / 2.0, H + u*c})
+		return lambda, R
+	}
+	return funf, funs, funroe
+}
+
+func BenchmarkPDEFVEuler(b *testing.B) {
+	x74 := goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 0.2})
+	for i := 0; i < b.N; i++ {
+		funf, _, funroe := goNum.PDEFVEuler(1.4)
+		goNum.PDEFVSolve(goNum.PDEFVFluxRoe(funf, funroe), fun74_p, x74, 3, 100, 200, 0, false)
+	}
+}
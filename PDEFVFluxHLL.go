@@ -0,0 +1,70 @@
+// PDEFVFluxHLL
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    守恒律（组）的HLL近似Riemann解数值通量
+理论：
+    对于守恒律（组）dU/dt + df(U)/dx = 0，以两端特征速度估计
+    左、右波速(Davis估计)：
+    sL = min(lambda_min(UL), lambda_min(UR))
+    sR = max(lambda_max(UL), lambda_max(UR))
+                f(UL),  sL >= 0
+    F(UL, UR) = f(UR),  sR <= 0
+                sR*f(UL) - sL*f(UR) + sL*sR(UR - UL)
+                ------------------------------------, 其他
+                             sR - sL
+    与PDEFVSolve配合即为Godunov型格式。
+
+    参考 A. Harten, P.D. Lax and B. van Leer. On upstream
+         differencing and Godunov-type schemes for hyperbolic
+         conservation laws. SIAM Rev., 1983, 25(1): 35-61.
+------------------------------------------------------
+输入   :
+    funf    通量函数f(U)，U为fnx1
+    funs    特征速度范围(U)，返回(最小特征值, 最大特征值)，
+            如PDEFVShallowWater、PDEFVEuler
+输出   :
+    flux    数值通量函数(UL, UR, r)，r = ht/hx，用于PDEFVSolve
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+// PDEFVFluxHLL 守恒律（组）的HLL近似Riemann解数值通量
+func PDEFVFluxHLL(funf func(Matrix) Matrix, funs func(Matrix) (float64, float64)) func(Matrix, Matrix, float64) Matrix {
+	/*
+		守恒律（组）的HLL近似Riemann解数值通量
+		输入   :
+		    funf    通量函数f(U)，U为fnx1
+		    funs    特征速度范围(U)，返回(最小特征值, 最大特征值)，
+		            如PDEFVShallowWater、PDEFVEuler
+		输出   :
+		    flux    数值通量函数(UL, UR, r)，r = ht/hx，用于PDEFVSolve
+	*/
+	return func(UL, UR Matrix, r float64) Matrix {
+		fL := funf(UL)
+		fR := funf(UR)
+		sL1, sR1 := funs(UL)
+		sL2, sR2 := funs(UR)
+		sL := math.Min(sL1, sL2)
+		sR := math.Max(sR1, sR2)
+		switch {
+		case sL >= 0.0:
+			return fL
+		case sR <= 0.0:
+			return fR
+		}
+		sol := ZeroMatrix(UL.Rows, 1)
+		for k := 0; k < UL.Rows; k++ {
+			sol.Data[k] = (sR*fL.Data[k] - sL*fR.Data[k] + sL*sR*(UR.Data[k]-UL.Data[k])) / (sR - sL)
+		}
+		return sol
+	}
+}
@@ -0,0 +1,82 @@
+// PDEFVFluxHLL_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    守恒律（组）的HLL近似Riemann解数值通量
+理论：
+    对于守恒律（组）dU/dt + df(U)/dx = 0，以两端特征速度估计
+    左、右波速(Davis估计)：
+    sL = min(lambda_min(UL), lambda_min(UR))
+    sR = max(lambda_max(UL), lambda_max(UR))
+                f(UL),  sL >= 0
+    F(UL, UR) = f(UR),  sR <= 0
+                sR*f(UL) - sL*f(UR) + sL*sR(UR - UL)
+                ------------------------------------, 其他
+                             sR - sL
+    与PDEFVSolve配合即为Godunov型格式。
+
+    参考 A. Harten, P.D. Lax and B. van Leer. On upstream
+         differencing and Godunov-type schemes for hyperbolic
+         conservation laws. SIAM Rev., 1983, 25(1): 35-61.
+------------------------------------------------------
+输入   :
+    funf    通量函数f(U)，U为fnx1
+    funs    特征速度范围(U)，返回(最小特征值, 最大特征值)，
+            如PDEFVShallowWater、PDEFVEuler
+输出   :
+    flux    数值通量函数(UL, UR, r)，r = ht/hx，用于PDEFVSolve
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// PDEFVFluxHLL 守恒律（组）的HLL近似Riemann解数值通量
+func PDEFVFluxHLL(funf func(goNum.Matrix) goNum.Matrix, funs func(goNum.Matrix) (float64, float64)) func(goNum.Matrix, goNum.Matrix, float64) goNum.Matrix {
+	/*
+		守恒律（组）的HLL近似Riemann解数值通量
+		输入   :
+		    funf    通量函数f(U)，U为fnx1
+		    funs    特征速度范围(U)，返回(最小特征值, 最大特征值)，
+		            如PDEFVShallowWater、PDEFVEuler
+		输出   :
+		    flux    数值通量函数(UL, UR, r)，r = ht/hx，用于PDEFVSolve
+	*/
+	return func(UL, UR goNum.Matrix, r float64) goNum.Matrix {
+		fL := funf(UL)
+		fR := funf(UR)
+		sL1, sR1 := funs(UL)
+		sL2, sR2 := funs(UR)
+		sL := math.Min(sL1, sL2)
+		sR := math.Max(sR1, sR2)
+		switch {
+		case sL >= 0.0:
+			return fL
+		case sR <= 0.0:
+			return fR
+		}
+		sol := goNum.ZeroMatrix(UL.Rows, 1)
+		for k := 0; k < UL.Rows; k++ {
+			sol.Data[k] = (sR*fL.Data[k] - sL*fR.Data[k] + sL*sR*(UR.Data[k]-UL.Data[k])) / (sR - sL)
+		}
+		return sol
+	}
+}
+
+func BenchmarkPDEFVFluxHLL(b *testing.B) {
+	x74 := goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 0.2})
+	funf, funs, _ := goNum.PDEFVEuler(1.4)
+	flux := goNum.PDEFVFluxHLL(funf, funs)
+	for i := 0; i < b.N; i++ {
+		goNum.PDEFVSolve(flux, fun74_p, x74, 3, 100, 200, 1, false)
+	}
+}
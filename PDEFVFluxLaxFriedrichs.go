@@ -0,0 +1,47 @@
+// PDEFVFluxLaxFriedrichs
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    守恒律（组）的Lax-Friedrichs数值通量
+理论：
+    对于守恒律（组）dU/dt + df(U)/dx = 0，r = ht/hx：
+                  1                     1
+    F(UL, UR) = ---(f(UL) + f(UR)) - ----(UR - UL)
+                  2                    2r
+    与PDEFVSolve配合即为一阶Lax-Friedrichs格式，须满足
+    max|lambda|*r <= 1。
+
+    参考 R.J. LeVeque. Finite Volume Methods for Hyperbolic
+         Problems. Cambridge University Press, 2002. ss 4.6.
+------------------------------------------------------
+输入   :
+    funf    通量函数f(U)，U为fnx1
+输出   :
+    flux    数值通量函数(UL, UR, r)，r = ht/hx，用于PDEFVSolve
+------------------------------------------------------
+*/
+
+package goNum
+
+// PDEFVFluxLaxFriedrichs 守恒律（组）的Lax-Friedrichs数值通量
+func PDEFVFluxLaxFriedrichs(funf func(Matrix) Matrix) func(Matrix, Matrix, float64) Matrix {
+	/*
+		守恒律（组）的Lax-Friedrichs数值通量
+		输入   :
+		    funf    通量函数f(U)，U为fnx1
+		输出   :
+		    flux    数值通量函数(UL, UR, r)，r = ht/hx，用于PDEFVSolve
+	*/
+	return func(UL, UR Matrix, r float64) Matrix {
+		fL := funf(UL)
+		fR := funf(UR)
+		sol := ZeroMatrix(UL.Rows, 1)
+		for k := 0; k < UL.Rows; k++ {
+			sol.Data[k] = (fL.Data[k]+fR.Data[k])/2.0 - (UR.Data[k]-UL.Data[k])/(2.0*r)
+		}
+		return sol
+	}
+}
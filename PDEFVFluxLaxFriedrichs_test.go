@@ -0,0 +1,61 @@
+// PDEFVFluxLaxFriedrichs_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    守恒律（组）的Lax-Friedrichs数值通量
+理论：
+    对于守恒律（组）dU/dt + df(U)/dx = 0，r = ht/hx：
+                  1                     1
+    F(UL, UR) = ---(f(UL) + f(UR)) - ----(UR - UL)
+                  2                    2r
+    与PDEFVSolve配合即为一阶Lax-Friedrichs格式，须满足
+    max|lambda|*r <= 1。
+
+    参考 R.J. LeVeque. Finite Volume Methods for Hyperbolic
+         Problems. Cambridge University Press, 2002. ss 4.6.
+------------------------------------------------------
+输入   :
+    funf    通量函数f(U)，U为fnx1
+输出   :
+    flux    数值通量函数(UL, UR, r)，r = ht/hx，用于PDEFVSolve
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// PDEFVFluxLaxFriedrichs 守恒律（组）的Lax-Friedrichs数值通量
+func PDEFVFluxLaxFriedrichs(funf func(goNum.Matrix) goNum.Matrix) func(goNum.Matrix, goNum.Matrix, float64) goNum.Matrix {
+	/*
+		守恒律（组）的Lax-Friedrichs数值通量
+		输入   :
+		    funf    通量函数f(U)，U为fnx1
+		输出   :
+		    flux    数值通量函数(UL, UR, r)，r = ht/hx，用于PDEFVSolve
+	*/
+	return func(UL, UR goNum.Matrix, r float64) goNum.Matrix {
+		fL := funf(UL)
+		fR := funf(UR)
+		sol := goNum.ZeroMatrix(UL.Rows, 1)
+		for k := 0; k < UL.Rows; k++ {
+			sol.Data[k] = (fL.Data[k]+fR.Data[k])/2.0 - (UR.Data[k]-UL.Data[k])/(2.0*r)
+		}
+		return sol
+	}
+}
+
+func BenchmarkPDEFVFluxLaxFriedrichs(b *testing.B) {
+	x73 := goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 0.2})
+	flux := goNum.PDEFVFluxLaxFriedrichs(fun73)
+	for i := 0; i < b.N; i++ {
+		goNum.PDEFVSolve(flux, fun73_p, x73, 1, 200, 100, 0, false)
+	}
+}
@@ -0,0 +1,62 @@
+// PDEFVFluxLaxWendroff_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    守恒律（组）的Lax-Wendroff（Richtmyer两步）数值通量
+理论：
+    对于守恒律（组）dU/dt + df(U)/dx = 0，r = ht/hx：
+            1             r
+    U* = ---(UL + UR) - ---(f(UR) - f(UL))
+            2             2
+    F(UL, UR) = f(U*)
+    与PDEFVSolve(limiter = 0)配合即为二阶Lax-Wendroff格式，须满足
+    max|lambda|*r <= 1，间断附近产生数值振荡。
+
+    参考 R.J. LeVeque. Finite Volume Methods for Hyperbolic
+         Problems. Cambridge University Press, 2002. ss 4.7.
+------------------------------------------------------
+输入   :
+    funf    通量函数f(U)，U为fnx1
+输出   :
+    flux    数值通量函数(UL, UR, r)，r = ht/hx，用于PDEFVSolve
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// PDEFVFluxLaxWendroff 守恒律（组）的Lax-Wendroff（Richtmyer两步）数值通量
+func PDEFVFluxLaxWendroff(funf func(goNum.Matrix) goNum.Matrix) func(goNum.Matrix, goNum.Matrix, float64) goNum.Matrix {
+	/*
+		守恒律（组）的Lax-Wendroff（Richtmyer两步）数值通量
+		输入   :
+		    funf    通量函数f(U)，U为fnx1
+		输出   :
+		    flux    数值通量函数(UL, UR, r)，r = ht/hx，用于PDEFVSolve
+	*/
+	return func(UL, UR goNum.Matrix, r float64) goNum.Matrix {
+		fL := funf(UL)
+		fR := funf(UR)
+		Us := goNum.ZeroMatrix(UL.Rows, 1)
+		for k := 0; k < UL.Rows; k++ {
+			Us.Data[k] = (UL.Data[k]+UR.Data[k])/2.0 - r*(fR.Data[k]-fL.Data[k])/2.0
+		}
+		return funf(Us)
+	}
+}
+
+func BenchmarkPDEFVFluxLaxWendroff(b *testing.B) {
+	x73 := goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 0.2})
+	flux := goNum.PDEFVFluxLaxWendroff(fun73)
+	for i := 0; i < b.N; i++ {
+		goNum.PDEFVSolve(flux, fun73_p, x73, 1, 200, 100, 0, false)
+	}
+}
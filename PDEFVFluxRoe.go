@@ -0,0 +1,99 @@
+// PDEFVFluxRoe
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    守恒律（组）的Roe近似Riemann解数值通量
+理论：
+    对于守恒律（组）dU/dt + df(U)/dx = 0，Roe矩阵A(UL, UR)的
+    特征值lambda_k、右特征向量r_k，波强alpha = R^(-1)(UR - UL)：
+                  1                     1  fn
+    F(UL, UR) = ---(f(UL) + f(UR)) - --- Sum |lambda_k|*alpha_k*r_k
+                  2                     2 k=1
+    |lambda|以Harten-Hyman熵修正，lambda_k(U)为U处的特征值：
+    delta = max(0, lambda_k - lambda_k(UL), lambda_k(UR) - lambda_k)
+    |lambda| < delta时取(lambda^2 + delta^2)/(2delta)
+    funroe为nil时按标量守恒律处理，lambda取Murman-Roe特征速度。
+    与PDEFVSolve配合即为Godunov型格式。
+
+    参考 P.L. Roe. Approximate Riemann solvers, parameter
+         vectors, and difference schemes. J. Comput. Phys.,
+         1981, 43(2): 357-372.
+------------------------------------------------------
+输入   :
+    funf    通量函数f(U)，U为fnx1
+    funroe  Roe矩阵特征结构(UL, UR)，返回特征值(fnx1)与右特征
+            向量矩阵(fnxfn，按列)，如PDEFVShallowWater、PDEFVEuler
+输出   :
+    flux    数值通量函数(UL, UR, r)，r = ht/hx，用于PDEFVSolve
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+// PDEFVFluxRoe 守恒律（组）的Roe近似Riemann解数值通量
+func PDEFVFluxRoe(funf func(Matrix) Matrix, funroe func(Matrix, Matrix) (Matrix, Matrix)) func(Matrix, Matrix, float64) Matrix {
+	/*
+		守恒律（组）的Roe近似Riemann解数值通量
+		输入   :
+		    funf    通量函数f(U)，U为fnx1
+		    funroe  Roe矩阵特征结构(UL, UR)，返回特征值(fnx1)与右特征
+		            向量矩阵(fnxfn，按列)，如PDEFVShallowWater、PDEFVEuler
+		输出   :
+		    flux    数值通量函数(UL, UR, r)，r = ht/hx，用于PDEFVSolve
+	*/
+	return func(UL, UR Matrix, r float64) Matrix {
+		fn := UL.Rows
+		fL := funf(UL)
+		fR := funf(UR)
+		var lambda, lamL, lamR, alpha, R Matrix
+		if funroe == nil {
+			if fn != 1 {
+				panic("Error in goNum.PDEFVFluxRoe: funroe is nil for systems")
+			}
+			a := speed_PDEFVFluxUpwind(funf, UL.Data[0], UR.Data[0], fL.Data[0], fR.Data[0])
+			aL := speed_PDEFVFluxUpwind(funf, UL.Data[0], UL.Data[0], fL.Data[0], fL.Data[0])
+			aR := speed_PDEFVFluxUpwind(funf, UR.Data[0], UR.Data[0], fR.Data[0], fR.Data[0])
+			lambda = NewMatrix(1, 1, []float64{a})
+			lamL = NewMatrix(1, 1, []float64{aL})
+			lamR = NewMatrix(1, 1, []float64{aR})
+			alpha = NewMatrix(1, 1, []float64{UR.Data[0] - UL.Data[0]})
+			R = IdentityE(1)
+		} else {
+			lambda, R = funroe(UL, UR)
+			lamL, _ = funroe(UL, UL)
+			lamR, _ = funroe(UR, UR)
+			dU := make([]float64, fn)
+			for k := 0; k < fn; k++ {
+				dU[k] = UR.Data[k] - UL.Data[k]
+			}
+			a, errtemp := LEs_ECPE(Matrix2ToSlices(R), dU)
+			if errtemp != true {
+				panic("Error in goNum.PDEFVFluxRoe: Eigenvectors are singular")
+			}
+			alpha = Slices1ToMatrix(a)
+		}
+		sol := ZeroMatrix(fn, 1)
+		for i := 0; i < fn; i++ {
+			sol.Data[i] = (fL.Data[i] + fR.Data[i]) / 2.0
+		}
+		for k := 0; k < fn; k++ {
+			//Harten-Hyman熵修正
+			l := math.Abs(lambda.Data[k])
+			delta := math.Max(0.0, math.Max(lambda.Data[k]-lamL.Data[k], lamR.Data[k]-lambda.Data[k]))
+			if l < delta {
+				l = (l*l + delta*delta) / (2.0 * delta)
+			}
+			for i := 0; i < fn; i++ {
+				sol.Data[i] -= l * alpha.Data[k] * R.GetFromMatrix(i, k) / 2.0
+			}
+		}
+		return sol
+	}
+}
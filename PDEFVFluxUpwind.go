@@ -0,0 +1,68 @@
+// PDEFVFluxUpwind
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    标量守恒律的迎风数值通量
+理论：
+    对于标量守恒律 du/dt + df(u)/dx = 0，以Murman-Roe特征速度
+         f(uR) - f(uL)
+    a = ---------------, uL = uR时以差分近似f'(uL)
+           uR - uL
+    判断迎风方向：
+    F(uL, uR) = f(uL), a >= 0
+                f(uR), a < 0
+    与PDEFVSolve配合即为一阶迎风格式，须满足|a|*r <= 1。
+    跨声速稀疏波处会出现非物理的膨胀激波，此时可使用含熵修正的
+    PDEFVFluxRoe或PDEFVFluxHLL；对于方程组可使用PDEFVFluxRoe。
+
+    参考 R.J. LeVeque. Finite Volume Methods for Hyperbolic
+         Problems. Cambridge University Press, 2002. ss 12.2.
+------------------------------------------------------
+输入   :
+    funf    通量函数f(U)，U为1x1
+输出   :
+    flux    数值通量函数(UL, UR, r)，r = ht/hx，用于PDEFVSolve
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+//Murman-Roe特征速度，uL = uR时以差分近似f'(uL)
+func speed_PDEFVFluxUpwind(funf func(Matrix) Matrix, uL, uR, fL, fR float64) float64 {
+	du := uR - uL
+	eps := math.Sqrt(2.220446049250313e-16) * math.Max(math.Abs(uL), 1.0)
+	if math.Abs(du) > eps {
+		return (fR - fL) / du
+	}
+	return (funf(NewMatrix(1, 1, []float64{uL + eps})).Data[0] - fL) / eps
+}
+
+// PDEFVFluxUpwind 标量守恒律的迎风数值通量
+func PDEFVFluxUpwind(funf func(Matrix) Matrix) func(Matrix, Matrix, float64) Matrix {
+	/*
+		标量守恒律的迎风数值通量
+		输入   :
+		    funf    通量函数f(U)，U为1x1
+		输出   :
+		    flux    数值通量函数(UL, UR, r)，r = ht/hx，用于PDEFVSolve
+	*/
+	return func(UL, UR Matrix, r float64) Matrix {
+		if (UL.Rows != 1) || (UR.Rows != 1) {
+			panic("Error in goNum.PDEFVFluxUpwind: Upwind flux is only for scalar laws")
+		}
+		fL := funf(UL)
+		fR := funf(UR)
+		a := speed_PDEFVFluxUpwind(funf, UL.Data[0], UR.Data[0], fL.Data[0], fR.Data[0])
+		if a >= 0.0 {
+			return fL
+		}
+		return fR
+	}
+}
@@ -0,0 +1,82 @@
+// PDEFVShallowWater
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    一维浅水方程组的通量、特征速度与Roe特征结构
+理论：
+    一维浅水方程组：
+     d  | h  |    d  |    hu       |
+    --- |    | + --- |             | = 0
+     dt | hu |    dx | hu^2+gh^2/2 |
+
+    特征值：u - c, u + c，c = sqrt(gh)
+
+    Roe平均：
+         sqrt(hL)uL + sqrt(hR)uR
+    u~ = ----------------------- , c~ = sqrt(g(hL + hR)/2)
+           sqrt(hL) + sqrt(hR)
+    特征值lambda = u~ - c~, u~ + c~
+    右特征向量r1 = [1, u~ - c~]'，r2 = [1, u~ + c~]'
+
+    与PDEFVFluxLaxFriedrichs、PDEFVFluxLaxWendroff、PDEFVFluxRoe、
+    PDEFVFluxHLL及PDEFVSolve配合使用，U = [h, hu]'。
+
+    参考 R.J. LeVeque. Finite Volume Methods for Hyperbolic
+         Problems. Cambridge University Press, 2002. ss 13, 15.3.
+------------------------------------------------------
+输入   :
+    g       重力加速度
+输出   :
+    funf    通量函数f(U)
+    funs    特征速度范围(U)，返回(最小特征值, 最大特征值)
+    funroe  Roe矩阵特征结构(UL, UR)，返回特征值与右特征向量矩阵
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+// PDEFVShallowWater 一维浅水方程组的通量、特征速度与Roe特征结构
+func PDEFVShallowWater(g float64) (func(Matrix) Matrix, func(Matrix) (float64, float64),
+	func(Matrix, Matrix) (Matrix, Matrix)) {
+	/*
+		一维浅水方程组的通量、特征速度与Roe特征结构
+		输入   :
+		    g       重力加速度
+		输出   :
+		    funf    通量函数f(U)
+		    funs    特征速度范围(U)，返回(最小特征值, 最大特征值)
+		    funroe  Roe矩阵特征结构(UL, UR)，返回特征值与右特征向量矩阵
+	*/
+	//判断g
+	if g <= 0.0 {
+		panic("Error in goNum.PDEFVShallowWater: g less than or equal to zero")
+	}
+
+	funf := func(U Matrix) Matrix {
+		h, hu := U.Data[0], U.Data[1]
+		return NewMatrix(2, 1, []float64{hu, hu*hu/h + g*h*h/2.0})
+	}
+	funs := func(U Matrix) (float64, float64) {
+		h := U.Data[0]
+		u := U.Data[1] / h
+		c := math.Sqrt(g * h)
+		return u - c, u + c
+	}
+	funroe := func(UL, UR Matrix) (Matrix, Matrix) {
+		hL, hR := UL.Data[0], UR.Data[0]
+		sL, sR := math.Sqrt(hL), math.Sqrt(hR)
+		u := (UL.Data[1]/sL + UR.Data[1]/sR) / (sL + sR)
+		c := math.Sqrt(g * (hL + hR) / 2.0)
+		lambda := NewMatrix(2, 1, []float64{u - c, u + c})
+		R := NewMatrix(2, 2, []float64{1.0, 1.0, u - c, u + c})
+		return lambda, R
+	}
+	return funf, funs, funroe
+}
@@ -0,0 +1,103 @@
+// PDEFVShallowWater_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    一维浅水方程组的通量、特征速度与Roe特征结构
+理论：
+    一维浅水方程组：
+     d  | h  |    d  |    hu       |
+    --- |    | + --- |             | = 0
+     dt | hu |    dx | hu^2+gh^2/2 |
+
+    特征值：u - c, u + c，c = sqrt(gh)
+
+    Roe平均：
+         sqrt(hL)uL + sqrt(hR)uR
+    u~ = ----------------------- , c~ = sqrt(g(hL + hR)/2)
+           sqrt(hL) + sqrt(hR)
+    特征值lambda = u~ - c~, u~ + c~
+    右特征向量r1 = [1, u~ - c~]'，r2 = [1, u~ + c~]'
+
+    与PDEFVFluxLaxFriedrichs、PDEFVFluxLaxWendroff、PDEFVFluxRoe、
+    PDEFVFluxHLL及PDEFVSolve配合使用，U = [h, hu]'。
+
+    参考 R.J. LeVeque. Finite Volume Methods for Hyperbolic
+         Problems. Cambridge University Press, 2002. ss 13, 15.3.
+------------------------------------------------------
+输入   :
+    g       重力加速度
+输出   :
+    funf    通量函数f(U)
+    funs    特征速度范围(U)，返回(最小特征值, 最大特征值)
+    funroe  Roe矩阵特征结构(UL, UR)，返回特征值与右特征向量矩阵
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// PDEFVShallowWater 一维浅水方程组的通量、特征速度与Roe特征结构
+func PDEFVShallowWater(g float64) (func(goNum.Matrix) goNum.Matrix, func(goNum.Matrix) (float64, float64),
+	func(goNum.Matrix, goNum.Matrix) (goNum.Matrix, goNum.Matrix)) {
+	/*
+		一维浅水方程组的通量、特征速度与Roe特征结构
+		输入   :
+		    g       重力加速度
+		输出   :
+		    funf    通量函数f(U)
+		    funs    特征速度范围(U)，返回(最小特征值, 最大特征值)
+		    funroe  Roe矩阵特征结构(UL, UR)，返回特征值与右特征向量矩阵
+	*/
+	//判断g
+	if g <= 0.0 {
+		panic("Error in goNum.PDEFVShallowWater: g less than or equal to zero")
+	}
+
+	funf := func(U goNum.Matrix) goNum.Matrix {
+		h, hu := U.Data[0], U.Data[1]
+		return goNum.NewMatrix(2, 1, []float64{hu, hu*hu/h + g*h*h/2.0})
+	}
+	funs := func(U goNum.Matrix) (float64, float64) {
+		h := U.Data[0]
+		u := U.Data[1] / h
+		c := math.Sqrt(g * h)
+		return u - c, u + c
+	}
+	funroe := func(UL, UR goNum.Matrix) (goNum.Matrix, goNum.Matrix) {
+		hL, hR := UL.Data[0], UR.Data[0]
+		sL, sR := math.Sqrt(hL), math.Sqrt(hR)
+		u := (UL.Data[1]/sL + UR.Data[1]/sR) / (sL + sR)
+		c := math.Sqrt(g * (hL + hR) / 2.0)
+		lambda := goNum.NewMatrix(2, 1, []float64{u - c, u + c})
+		R := goNum.NewMatrix(2, 2, []float64{1.0, 1.0, u - c, u + c})
+		return lambda, R
+	}
+	return funf, funs, funroe
+}
+
+func fun75_p(x float64, i int) float64 {
+	if i == 1 {
+		return 0.0
+	}
+	if x < 0.5 {
+		return 2.0
+	}
+	return 1.0
+}
+
+func BenchmarkPDEFVShallowWater(b *testing.B) {
+	x75 := goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 0.2})
+	for i := 0; i < b.N; i++ {
+		funf, funs, _ := goNum.PDEFVShallowWater(1.0)
+		goNum.PDEFVSolve(goNum.PDEFVFluxHLL(funf, funs), fun75_p, x75, 2, 100, 100, 1, false)
+	}
+}
@@ -0,0 +1,250 @@
+// PDEFVSolve_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    求解一维双曲型守恒律（组）的有限体积法
+理论：
+    对于一维双曲型守恒律（组）：
+     dU     df(U)
+    ---- + ------- = 0, U为fn维
+     dt      dx
+
+    U(x, 0) = p(x)
+    0 < x < L, 0 < t < T
+
+    x分为m个单元，单元中心x_i = (i+1/2)hx，单元平均值Ui，
+    r = ht/hx，守恒型格式：
+    U_i^(n+1) = U_i^n - r(F_(i+1/2) - F_(i-1/2))
+    F_(i+1/2) = F(U_(i+1/2)^L, U_(i+1/2)^R, r)为数值通量，由
+    PDEFVFluxUpwind、PDEFVFluxLaxFriedrichs、PDEFVFluxLaxWendroff、
+    PDEFVFluxRoe、PDEFVFluxHLL等给出，以Roe/HLL通量即为Godunov
+    型格式。
+
+    limiter = 0时取U_(i+1/2)^L = Ui，U_(i+1/2)^R = U_(i+1)，一阶；
+    limiter > 0时为MUSCL格式，各分量分片线性重构：
+    U_(i+1/2)^L = Ui + si/2，U_(i+1/2)^R = U_(i+1) - s_(i+1)/2
+    si = phi(Ui - U_(i-1), U_(i+1) - Ui)
+    1-minmod限制器：phi(a, b) = sign(a)min(|a|, |b|)，ab > 0；否则0
+    2-superbee限制器：
+    phi(a, b) = maxmod(minmod(2a, b), minmod(a, 2b))
+    时间方向取二阶TVD Runge-Kutta（Heun）法，二阶精度。
+    PDEFVFluxLaxWendroff本身为二阶格式，应取limiter = 0。
+
+    边界：periodic = true为周期边界；否则为外流（零梯度）边界，
+    两端各取两个虚单元U_(-1) = U_(-2) = U0，U_m = U_(m+1) = U_(m-1)。
+
+    稳定性须满足CFL条件 max|lambda|*r <= 1（MUSCL格式取1/2）。
+
+    参考 R.J. LeVeque. Finite Volume Methods for Hyperbolic
+         Problems. Cambridge University Press, 2002. ss 4, 6.
+------------------------------------------------------
+输入   :
+    flux    数值通量函数(U^L, U^R, r)，各为fnx1
+    funp    初值函数p(x, i)，第i个分量
+    x0      求解范围，2x2
+    fn      方程个数
+    m, n    x方向单元数与t方向网格数量
+    limiter 限制器，0-无(一阶)，1-minmod，2-superbee
+    periodic  true-周期边界；false-外流边界
+输出   :
+    sol     解矩阵，(fn*m)x(n+1)，第k*m+i行为第k个分量在第i个
+            单元上的平均值
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+//minmod函数
+func minmod_PDEFVSolve(a, b float64) float64 {
+	if a*b <= 0.0 {
+		return 0.0
+	}
+	if math.Abs(a) < math.Abs(b) {
+		return a
+	}
+	return b
+}
+
+//斜率限制器，1-minmod，2-superbee
+func slope_PDEFVSolve(a, b float64, limiter int) float64 {
+	switch limiter {
+	case 1:
+		return minmod_PDEFVSolve(a, b)
+	default:
+		s1 := minmod_PDEFVSolve(2.0*a, b)
+		s2 := minmod_PDEFVSolve(a, 2.0*b)
+		if math.Abs(s1) > math.Abs(s2) {
+			return s1
+		}
+		return s2
+	}
+}
+
+// PDEFVSolve 求解一维双曲型守恒律（组）的有限体积法
+func PDEFVSolve(flux func(goNum.Matrix, goNum.Matrix, float64) goNum.Matrix, funp func(float64, int) float64,
+	x0 goNum.Matrix, fn, m, n, limiter int, periodic bool) (goNum.Matrix, bool) {
+	/*
+		求解一维双曲型守恒律（组）的有限体积法
+		输入   :
+		    flux    数值通量函数(U^L, U^R, r)，各为fnx1
+		    funp    初值函数p(x, i)，第i个分量
+		    x0      求解范围，2x2
+		    fn      方程个数
+		    m, n    x方向单元数与t方向网格数量
+		    limiter 限制器，0-无(一阶)，1-minmod，2-superbee
+		    periodic  true-周期边界；false-外流边界
+		输出   :
+		    sol     解矩阵，(fn*m)x(n+1)，第k*m+i行为第k个分量在第i个
+		            单元上的平均值
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断网格数量
+	if (m < 3) || (n < 1) || (fn < 1) {
+		panic("Error in goNum.PDEFVSolve: Grid numbers error")
+	}
+	//判断limiter
+	if (limiter < 0) || (limiter > 2) {
+		panic("Error in goNum.PDEFVSolve: limiter is not in [0, 2]")
+	}
+
+	var err bool = false
+	sol := goNum.ZeroMatrix(fn*m, n+1)
+	xa := x0.GetFromMatrix(0, 0)
+	hx := (x0.GetFromMatrix(1, 0) - xa) / float64(m)                     //x方向步长
+	ht := (x0.GetFromMatrix(1, 1) - x0.GetFromMatrix(0, 1)) / float64(n) //t方向步长
+	r := ht / hx
+
+	//初值，取单元中心值
+	U := make([][]float64, m)
+	for i := 0; i < m; i++ {
+		U[i] = make([]float64, fn)
+		for k := 0; k < fn; k++ {
+			U[i][k] = funp(xa+(float64(i)+0.5)*hx, k)
+			sol.SetMatrix(k*m+i, 0, U[i][k])
+		}
+	}
+
+	//含虚单元的第i个单元，i = -2, ..., m+1
+	ghost := func(V [][]float64, i int) []float64 {
+		switch {
+		case (i >= 0) && (i < m):
+			return V[i]
+		case periodic:
+			return V[(i+m)%m]
+		case i < 0:
+			return V[0]
+		default:
+			return V[m-1]
+		}
+	}
+	//通量差，D_i = F_(i+1/2) - F_(i-1/2)
+	UL := goNum.ZeroMatrix(fn, 1)
+	UR := goNum.ZeroMatrix(fn, 1)
+	diff := func(V [][]float64) [][]float64 {
+		F := make([][]float64, m+1) //F_(i-1/2), i = 0, ..., m
+		for i := 0; i < m+1; i++ {
+			vl, vr := ghost(V, i-1), ghost(V, i)
+			for k := 0; k < fn; k++ {
+				UL.Data[k] = vl[k]
+				UR.Data[k] = vr[k]
+				if limiter > 0 {
+					vll, vrr := ghost(V, i-2), ghost(V, i+1)
+					UL.Data[k] += slope_PDEFVSolve(vl[k]-vll[k], vr[k]-vl[k], limiter) / 2.0
+					UR.Data[k] -= slope_PDEFVSolve(vr[k]-vl[k], vrr[k]-vr[k], limiter) / 2.0
+				}
+			}
+			F[i] = append([]float64{}, flux(UL, UR, r).Data...)
+		}
+		D := make([][]float64, m)
+		for i := 0; i < m; i++ {
+			D[i] = make([]float64, fn)
+			for k := 0; k < fn; k++ {
+				D[i][k] = F[i+1][k] - F[i][k]
+			}
+		}
+		return D
+	}
+
+	for j := 1; j < n+1; j++ {
+		D := diff(U)
+		U1 := make([][]float64, m)
+		for i := 0; i < m; i++ {
+			U1[i] = make([]float64, fn)
+			for k := 0; k < fn; k++ {
+				U1[i][k] = U[i][k] - r*D[i][k]
+			}
+		}
+		if limiter > 0 {
+			//Heun法第二步
+			D1 := diff(U1)
+			for i := 0; i < m; i++ {
+				for k := 0; k < fn; k++ {
+					U1[i][k] = (U[i][k] + U1[i][k] - r*D1[i][k]) / 2.0
+				}
+			}
+		}
+		U = U1
+		for i := 0; i < m; i++ {
+			for k := 0; k < fn; k++ {
+				if math.IsNaN(U[i][k]) || math.IsInf(U[i][k], 0) {
+					return sol, err
+				}
+				sol.SetMatrix(k*m+i, j, U[i][k])
+			}
+		}
+	}
+
+	err = true
+	return sol, err
+}
+
+func fun73(U goNum.Matrix) goNum.Matrix {
+	return goNum.NewMatrix(1, 1, []float64{U.Data[0] * U.Data[0] / 2.0})
+}
+
+func fun73_s(U goNum.Matrix) (float64, float64) {
+	return U.Data[0], U.Data[0]
+}
+
+func fun73_p(x float64, i int) float64 {
+	if x < 0.5 {
+		return -1.0
+	}
+	return 1.0
+}
+
+func fun74_p(x float64, i int) float64 {
+	rho, p := 1.0, 1.0
+	if x >= 0.5 {
+		rho, p = 0.125, 0.1
+	}
+	switch i {
+	case 0:
+		return rho
+	case 1:
+		return 0.0
+	default:
+		return p / 0.4
+	}
+}
+
+func BenchmarkPDEFVSolve(b *testing.B) {
+	x73 := goNum.NewMatrix(2, 2, []float64{0.0, 0.0, 1.0, 0.2})
+	flux := goNum.PDEFVFluxHLL(fun73, fun73_s)
+	for i := 0; i < b.N; i++ {
+		goNum.PDEFVSolve(flux, fun73_p, x73, 1, 200, 100, 2, false)
+	}
+}
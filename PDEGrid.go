@@ -0,0 +1,191 @@
+// PDEGrid
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    偏微分方程数值解的网格与解类型及其操作
+理论：
+    数值解U为(nx)x(nt)矩阵，第i行第j列为u(Xi, Tj)，X、T为递增
+    (可不等距)节点。PDEDiff*等函数的解矩阵以x为行、t为列，可由
+    PDEGridUniform直接构造；行为y、列为x的椭圆型问题解矩阵，可
+    转置后以x、y代替x、t构造。
+
+    1. 取值：对Xi <= x <= X_(i+1)，Tj <= t <= T_(j+1)，双线性插值
+       sx = (x-Xi)/(X_(i+1)-Xi)，st = (t-Tj)/(T_(j+1)-Tj)
+       u(x, t) = (1-sx)(1-st)U_(i,j) + sx(1-st)U_(i+1,j)
+                 + (1-sx)st*U_(i,j+1) + sx*st*U_(i+1,j+1)
+    2. 切片：SliceT(t)为t时刻各x节点上的值，SliceX(x)为x处各t节点
+       上的值，非节点处线性插值。
+    3. 误差范数：e = U - u*(X, T)，
+       p = 0：max|e|
+       p = 1：sum w_ij|e_ij|
+       p = 2：sqrt(sum w_ij*e_ij^2)
+       w_ij为两方向复化梯形公式权重之积，即L1、L2范数的梯形
+       求积近似。ErrorNormT为t时刻沿x方向的误差范数。
+------------------------------------------------------
+注意事项：
+    1. X、T至少各含两个节点
+    2. 取值超出网格范围时panic
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+	"sort"
+)
+
+//数据结构定义----------------------------------------+
+// PDEGrid 定义偏微分方程数值解的网格与解类型
+type PDEGrid struct {
+	X, T []float64 //x方向（行）与t方向（列）节点，递增
+	U    Matrix    //解矩阵，len(X)xlen(T)
+}
+
+//网格初始化-----------------------------------------+
+// NewPDEGrid 以节点与解矩阵创建PDEGrid
+func NewPDEGrid(x, t []float64, u Matrix) PDEGrid {
+	if (len(x) < 2) || (len(t) < 2) {
+		panic("Error in goNum.NewPDEGrid: Grid numbers error")
+	}
+	if (u.Rows != len(x)) || (u.Columns != len(t)) {
+		panic("Error in goNum.NewPDEGrid: Size of u does not matched x and t")
+	}
+	for i := 0; i < len(x)-1; i++ {
+		if x[i+1] <= x[i] {
+			panic("Error in goNum.NewPDEGrid: x is not increasing")
+		}
+	}
+	for j := 0; j < len(t)-1; j++ {
+		if t[j+1] <= t[j] {
+			panic("Error in goNum.NewPDEGrid: t is not increasing")
+		}
+	}
+	return PDEGrid{x, t, u}
+}
+
+// PDEGridUniform 以求解范围x0(2x2，按列x、t)与解矩阵创建等距PDEGrid
+func PDEGridUniform(x0, u Matrix) PDEGrid {
+	if (u.Rows < 2) || (u.Columns < 2) {
+		panic("Error in goNum.PDEGridUniform: Grid numbers error")
+	}
+	x := make([]float64, u.Rows)
+	t := make([]float64, u.Columns)
+	xa, ta := x0.GetFromMatrix(0, 0), x0.GetFromMatrix(0, 1)
+	hx := (x0.GetFromMatrix(1, 0) - xa) / float64(u.Rows-1)    //x方向步长
+	ht := (x0.GetFromMatrix(1, 1) - ta) / float64(u.Columns-1) //t方向步长
+	for i := range x {
+		x[i] = xa + float64(i)*hx
+	}
+	for j := range t {
+		t[j] = ta + float64(j)*ht
+	}
+	return NewPDEGrid(x, t, u)
+}
+
+//网格操作-------------------------------------------+
+//查找v所在区间[s_k, s_(k+1)]，返回k与区间内相对位置
+func locate_PDEGrid(s []float64, v float64) (int, float64) {
+	n := len(s)
+	tol := 1e-12 * (s[n-1] - s[0])
+	if (v < s[0]-tol) || (v > s[n-1]+tol) {
+		panic("Error in goNum.PDEGrid: Out of range")
+	}
+	k := sort.SearchFloat64s(s, v) - 1
+	if k < 0 {
+		k = 0
+	}
+	if k > n-2 {
+		k = n - 2
+	}
+	return k, (v - s[k]) / (s[k+1] - s[k])
+}
+
+//复化梯形公式权重
+func weights_PDEGrid(s []float64) []float64 {
+	n := len(s)
+	w := make([]float64, n)
+	for k := 0; k < n-1; k++ {
+		h := s[k+1] - s[k]
+		w[k] += h / 2.0
+		w[k+1] += h / 2.0
+	}
+	return w
+}
+
+//误差范数累加
+func norm_PDEGrid(e, w []float64, p int) float64 {
+	var sol float64
+	for k := range e {
+		switch p {
+		case 0:
+			sol = math.Max(sol, math.Abs(e[k]))
+		case 1:
+			sol += w[k] * math.Abs(e[k])
+		case 2:
+			sol += w[k] * e[k] * e[k]
+		default:
+			panic("Error in goNum.PDEGrid: p is not 0, 1 or 2")
+		}
+	}
+	if p == 2 {
+		sol = math.Sqrt(sol)
+	}
+	return sol
+}
+
+// At 双线性插值求u(x, t)
+func (G *PDEGrid) At(x, t float64) float64 {
+	i, sx := locate_PDEGrid(G.X, x)
+	j, st := locate_PDEGrid(G.T, t)
+	return (1.0-sx)*(1.0-st)*G.U.GetFromMatrix(i, j) + sx*(1.0-st)*G.U.GetFromMatrix(i+1, j) +
+		(1.0-sx)*st*G.U.GetFromMatrix(i, j+1) + sx*st*G.U.GetFromMatrix(i+1, j+1)
+}
+
+// SliceT t时刻各x节点上的值，len(X)x1
+func (G *PDEGrid) SliceT(t float64) Matrix {
+	j, st := locate_PDEGrid(G.T, t)
+	sol := ZeroMatrix(len(G.X), 1)
+	for i := range G.X {
+		sol.Data[i] = (1.0-st)*G.U.GetFromMatrix(i, j) + st*G.U.GetFromMatrix(i, j+1)
+	}
+	return sol
+}
+
+// SliceX x处各t节点上的值，len(T)x1
+func (G *PDEGrid) SliceX(x float64) Matrix {
+	i, sx := locate_PDEGrid(G.X, x)
+	sol := ZeroMatrix(len(G.T), 1)
+	for j := range G.T {
+		sol.Data[j] = (1.0-sx)*G.U.GetFromMatrix(i, j) + sx*G.U.GetFromMatrix(i+1, j)
+	}
+	return sol
+}
+
+// ErrorNorm 全部节点上相对精确解exact(x, t)的误差范数，p = 0, 1, 2
+func (G *PDEGrid) ErrorNorm(exact func(float64, float64) float64, p int) float64 {
+	wx := weights_PDEGrid(G.X)
+	wt := weights_PDEGrid(G.T)
+	e := make([]float64, 0, len(G.X)*len(G.T))
+	w := make([]float64, 0, len(G.X)*len(G.T))
+	for i := range G.X {
+		for j := range G.T {
+			e = append(e, G.U.GetFromMatrix(i, j)-exact(G.X[i], G.T[j]))
+			w = append(w, wx[i]*wt[j])
+		}
+	}
+	return norm_PDEGrid(e, w, p)
+}
+
+// ErrorNormT t时刻沿x方向相对精确解exact(x, t)的误差范数，p = 0, 1, 2
+func (G *PDEGrid) ErrorNormT(exact func(float64, float64) float64, t float64, p int) float64 {
+	u := G.SliceT(t)
+	e := make([]float64, len(G.X))
+	for i := range G.X {
+		e[i] = u.Data[i] - exact(G.X[i], t)
+	}
+	return norm_PDEGrid(e, weights_PDEGrid(G.X), p)
+}
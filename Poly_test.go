@@ -0,0 +1,373 @@
+// Poly_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    单变量实系数多项式类型及其运算
+理论：
+    p(x) = a0 + a1x + a2x^2 + ... + anx^n，系数按幂次升序存储，
+    与DerivativePoly、InterpLagrangeFunc、InterpHermiteFunc、
+    FittingPolynomial的系数向量一致，可由PolyFromMatrix、
+    ToMatrix相互转换。
+
+    1. 求值：Horner格式
+       p(x) = (...((an*x + a_(n-1))x + a_(n-2))x + ...)x + a0
+    2. 加、减、乘：逐项相加减、卷积；带余除法p = q*s + r，
+       deg r < deg q，长除法。
+    3. 复合：p(q(x))，以q代替x的Horner格式。
+    4. 导数、积分：逐项求导、积分，积分常数为c。
+    5. 最大公因式：Euclid辗转相除，余式各系数绝对值不大于
+       tol*max|ai|时视为零，结果首项系数为1。
+    6. 全部复根：Aberth-Ehrlich同时迭代，
+                            wk
+       zk = zk - -------------------------，wk = p(zk)/p'(zk)
+                  1 - wk*Sum(1/(zk - zj))
+                           j!=k
+       初值取Fujiwara根界为半径的圆周上均匀分布的n个点，单根
+       三阶收敛，重根线性收敛，m重根精度约eps^(1/m)。零根
+       （a0 = a1 = ... = 0）直接分离。
+
+    参考 O. Aberth. Iteration methods for finding all zeros of
+         a polynomial simultaneously. Math. Comp., 1973,
+         27(122): 339-344.
+------------------------------------------------------
+注意事项：
+    1. 创建时去除高次零系数，零多项式为[0]，次数为0
+    2. 已有函数的Poly形式：PolyInterpLagrange、PolyInterpHermite、
+       PolyFitting，原函数不变
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+//数据结构定义----------------------------------------+
+// Poly 定义单变量实系数多项式类型
+type Poly struct {
+	Coef []float64 //系数，Coef[i]为x^i的系数
+}
+
+//多项式初始化---------------------------------------+
+// NewPoly 以按幂次升序的系数创建多项式
+func NewPoly(a []float64) Poly {
+	n := len(a)
+	for (n > 1) && (a[n-1] == 0.0) {
+		n--
+	}
+	coef := make([]float64, n)
+	copy(coef, a)
+	if n == 0 {
+		coef = []float64{0.0}
+	}
+	return Poly{coef}
+}
+
+// PolyFromMatrix 由系数向量创建多项式，A为(n+1)x1或1x(n+1)
+func PolyFromMatrix(A goNum.Matrix) Poly {
+	if (A.Rows != 1) && (A.Columns != 1) {
+		panic("Error in goNum.PolyFromMatrix: A is not a vector")
+	}
+	return NewPoly(A.Data)
+}
+
+// ToMatrix 系数向量，(n+1)x1
+func (p *Poly) ToMatrix() goNum.Matrix {
+	return goNum.Slices1ToMatrix(p.Coef)
+}
+
+// Degree 多项式次数
+func (p *Poly) Degree() int {
+	return len(p.Coef) - 1
+}
+
+//多项式运算-----------------------------------------+
+// Eval Horner格式求p(x)
+func (p *Poly) Eval(x float64) float64 {
+	n := len(p.Coef) - 1
+	sol := p.Coef[n]
+	for i := n - 1; i >= 0; i-- {
+		sol = sol*x + p.Coef[i]
+	}
+	return sol
+}
+
+// EvalComplex Horner格式求p(z)
+func (p *Poly) EvalComplex(z complex128) complex128 {
+	n := len(p.Coef) - 1
+	sol := complex(p.Coef[n], 0)
+	for i := n - 1; i >= 0; i-- {
+		sol = sol*z + complex(p.Coef[i], 0)
+	}
+	return sol
+}
+
+// Add p + q
+func (p *Poly) Add(q Poly) Poly {
+	n := len(p.Coef)
+	if len(q.Coef) > n {
+		n = len(q.Coef)
+	}
+	sol := make([]float64, n)
+	copy(sol, p.Coef)
+	for i := range q.Coef {
+		sol[i] += q.Coef[i]
+	}
+	return NewPoly(sol)
+}
+
+// Sub p - q
+func (p *Poly) Sub(q Poly) Poly {
+	n := len(p.Coef)
+	if len(q.Coef) > n {
+		n = len(q.Coef)
+	}
+	sol := make([]float64, n)
+	copy(sol, p.Coef)
+	for i := range q.Coef {
+		sol[i] -= q.Coef[i]
+	}
+	return NewPoly(sol)
+}
+
+// Scale c*p
+func (p *Poly) Scale(c float64) Poly {
+	sol := make([]float64, len(p.Coef))
+	for i := range p.Coef {
+		sol[i] = c * p.Coef[i]
+	}
+	return NewPoly(sol)
+}
+
+// Mul p*q
+func (p *Poly) Mul(q Poly) Poly {
+	sol := make([]float64, len(p.Coef)+len(q.Coef)-1)
+	for i := range p.Coef {
+		for j := range q.Coef {
+			sol[i+j] += p.Coef[i] * q.Coef[j]
+		}
+	}
+	return NewPoly(sol)
+}
+
+// DivMod 带余除法p = q*s + r，返回商s与余式r
+func (p *Poly) DivMod(q Poly) (Poly, Poly) {
+	m := len(q.Coef) - 1
+	if (m == 0) && (q.Coef[0] == 0.0) {
+		panic("Error in goNum.Poly.DivMod: Divided by zero polynomial")
+	}
+	n := len(p.Coef) - 1
+	if n < m {
+		return NewPoly(nil), NewPoly(p.Coef)
+	}
+	r := make([]float64, n+1)
+	copy(r, p.Coef)
+	s := make([]float64, n-m+1)
+	for k := n - m; k >= 0; k-- {
+		s[k] = r[k+m] / q.Coef[m]
+		for j := 0; j < m+1; j++ {
+			r[k+j] -= s[k] * q.Coef[j]
+		}
+		r[k+m] = 0.0
+	}
+	return NewPoly(s), NewPoly(r[:m])
+}
+
+// Compose 复合多项式p(q(x))
+func (p *Poly) Compose(q Poly) Poly {
+	n := len(p.Coef) - 1
+	sol := NewPoly([]float64{p.Coef[n]})
+	for i := n - 1; i >= 0; i-- {
+		sol = sol.Mul(q)
+		sol.Coef[0] += p.Coef[i]
+		sol = NewPoly(sol.Coef)
+	}
+	return sol
+}
+
+// Derivative 导数p'(x)
+func (p *Poly) Derivative() Poly {
+	n := len(p.Coef) - 1
+	if n == 0 {
+		return NewPoly(nil)
+	}
+	sol := make([]float64, n)
+	for i := 1; i < n+1; i++ {
+		sol[i-1] = float64(i) * p.Coef[i]
+	}
+	return NewPoly(sol)
+}
+
+// Integral 积分，积分常数为c
+func (p *Poly) Integral(c float64) Poly {
+	sol := make([]float64, len(p.Coef)+1)
+	sol[0] = c
+	for i := range p.Coef {
+		sol[i+1] = p.Coef[i] / float64(i+1)
+	}
+	return NewPoly(sol)
+}
+
+// GCD 最大公因式，首项系数为1，tol为余式判零的相对误差
+func (p *Poly) GCD(q Poly, tol float64) Poly {
+	//各系数绝对值最大值
+	scale := func(a Poly) float64 {
+		var sol float64
+		for i := range a.Coef {
+			sol = math.Max(sol, math.Abs(a.Coef[i]))
+		}
+		return sol
+	}
+	a := NewPoly(p.Coef)
+	b := NewPoly(q.Coef)
+	if scale(a) == 0.0 {
+		a, b = b, a
+	}
+	if scale(a) == 0.0 {
+		panic("Error in goNum.Poly.GCD: Both polynomials are zero")
+	}
+	a = a.Scale(1.0 / a.Coef[len(a.Coef)-1])
+	for scale(b) > tol*scale(a) {
+		b = b.Scale(1.0 / b.Coef[len(b.Coef)-1])
+		_, r := a.DivMod(b)
+		a, b = b, r
+	}
+	return a
+}
+
+// Roots 全部复根，按实部、虚部升序
+func (p *Poly) Roots() []complex128 {
+	n := len(p.Coef) - 1
+	sol := make([]complex128, 0, n)
+	//分离零根
+	k := 0
+	for (k < n) && (p.Coef[k] == 0.0) {
+		sol = append(sol, 0)
+		k++
+	}
+	q := NewPoly(p.Coef[k:])
+	m := len(q.Coef) - 1
+	switch {
+	case m == 1:
+		sol = append(sol, complex(-q.Coef[0]/q.Coef[1], 0))
+	case m > 1:
+		for _, z := range aberth_Poly(q) {
+			//实系数多项式舍去舍入误差引起的虚部
+			if math.Abs(imag(z)) <= 4.0*2.220446049250313e-16*cmplx.Abs(z) {
+				z = complex(real(z), 0)
+			}
+			sol = append(sol, z)
+		}
+	}
+	sort.Slice(sol, func(i, j int) bool {
+		if real(sol[i]) != real(sol[j]) {
+			return real(sol[i]) < real(sol[j])
+		}
+		return imag(sol[i]) < imag(sol[j])
+	})
+	return sol
+}
+
+//Aberth-Ehrlich同时迭代求p的全部根，p(0) != 0
+func aberth_Poly(p Poly) []complex128 {
+	n := len(p.Coef) - 1
+	dp := p.Derivative()
+	//Fujiwara根界
+	var r float64
+	for i := 0; i < n; i++ {
+		temp0 := math.Abs(p.Coef[i] / p.Coef[n])
+		if i == 0 {
+			temp0 /= 2.0
+		}
+		r = math.Max(r, math.Pow(temp0, 1.0/float64(n-i)))
+	}
+	r *= 2.0
+	z := make([]complex128, n)
+	for k := range z {
+		z[k] = cmplx.Rect(r, 2.0*math.Pi*float64(k)/float64(n)+0.4)
+	}
+	done := make([]bool, n)
+	for iter := 0; iter < 500; iter++ {
+		conv := true
+		for k := 0; k < n; k++ {
+			if done[k] {
+				continue
+			}
+			pz := p.EvalComplex(z[k])
+			if pz == 0 {
+				done[k] = true
+				continue
+			}
+			w := pz / dp.EvalComplex(z[k])
+			var s complex128
+			for j := 0; j < n; j++ {
+				if j != k {
+					s += 1.0 / (z[k] - z[j])
+				}
+			}
+			dz := w / (1.0 - w*s)
+			if cmplx.IsNaN(dz) || cmplx.IsInf(dz) {
+				dz = w
+			}
+			z[k] -= dz
+			if cmplx.Abs(dz) <= 4.0*2.220446049250313e-16*cmplx.Abs(z[k]) {
+				done[k] = true
+			} else {
+				conv = false
+			}
+		}
+		if conv {
+			break
+		}
+	}
+	return z
+}
+
+//已有函数的Poly形式---------------------------------+
+// PolyInterpLagrange 以Poly形式返回InterpLagrangeFunc的插值多项式
+func PolyInterpLagrange(A goNum.Matrix) (Poly, bool) {
+	B, err := goNum.InterpLagrangeFunc(A)
+	return PolyFromMatrix(B), err
+}
+
+// PolyInterpHermite 以Poly形式返回InterpHermiteFunc的插值多项式
+func PolyInterpHermite(A goNum.Matrix) (Poly, bool) {
+	B, err := goNum.InterpHermiteFunc(A)
+	return PolyFromMatrix(B), err
+}
+
+// PolyFitting 以Poly形式返回FittingPolynomial的拟合多项式
+func PolyFitting(xy goNum.Matrix, m int) (Poly, float64, float64, bool) {
+	B, RMS, MaxErr, err := goNum.FittingPolynomial(xy, m)
+	return PolyFromMatrix(B), RMS, MaxErr, err
+}
+
+//Wilkinson多项式(x-1)(x-2)...(x-n)
+func fun85(n int) goNum.Poly {
+	sol := goNum.NewPoly([]float64{1.0})
+	for k := 1; k < n+1; k++ {
+		sol = sol.Mul(goNum.NewPoly([]float64{-float64(k), 1.0}))
+	}
+	return sol
+}
+
+func BenchmarkPoly(b *testing.B) {
+	p85 := fun85(10)
+	q85 := goNum.NewPoly([]float64{-1.0, 0.0, 1.0})
+	for i := 0; i < b.N; i++ {
+		p85.Eval(2.5)
+		p85.DivMod(q85)
+		p85.Compose(q85)
+		p85.GCD(q85, 1e-10)
+		p85.Roots()
+	}
+}
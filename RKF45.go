@@ -4,6 +4,7 @@
 作者   : Black Ghost
 日期   : 2018-12-19
 版本   : 0.0.0
+         0.0.1 2026-10-19 末步截断以恰好终止于xend，增加步数上限保护
 ------------------------------------------------------
     四级五阶变步长Runge-Kutta法求解常微分方程组
 理论：
@@ -75,6 +76,16 @@ func RKF45(fun func(Matrix, int) float64, x0 Matrix,
 	var i, nreal int = 1, 1
 
 	for sol0.GetFromMatrix(0, i-1) < xend { //最大迭代次数控制
+		//步数上限
+		if i > n {
+			break
+		}
+		//末步截断，保证恰好终止于xend
+		lastStep := false
+		if sol0.GetFromMatrix(0, i-1)+h >= xend {
+			h = xend - sol0.GetFromMatrix(0, i-1)
+			lastStep = true
+		}
 		temp0 := ZeroMatrix(fn+1, 1)
 		//给temp0赋i-1步值，每一步开始
 		for j := 0; j < fn+1; j++ {
@@ -148,6 +159,9 @@ func RKF45(fun func(Matrix, int) float64, x0 Matrix,
 		if math.Abs(errtemp0) < tol {
 			//i步值
 			sol0.SetMatrix(0, i, sol0.GetFromMatrix(0, i-1)+h) //xi
+			if lastStep {
+				sol0.SetMatrix(0, i, xend)
+			}
 			for j := 1; j < fn+1; j++ {
 				soltemp1 := 25.0*k1.Data[j-1]/216.0 + 1408.0*k3.Data[j-1]/2565.0 +
 					2197.0*k4.Data[j-1]/4104.0 - k5.Data[j-1]/5.0
@@ -182,6 +196,8 @@ func RKF45(fun func(Matrix, int) float64, x0 Matrix,
 		}
 	}
 
-	err = true
+	if sol.GetFromMatrix(0, nreal-1) >= xend {
+		err = true
+	}
 	return sol, err
 }
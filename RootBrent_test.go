@@ -0,0 +1,153 @@
+// RootBrent_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    Brent法求解方程 f(x)=0 在区间[a, b]内的根
+理论：
+    始终保持有根区间[b, c]，f(b)f(c) <= 0，|f(b)| <= |f(c)|，
+    b为当前最优近似，a为上一步的b。
+    1. a = c时取割线步，否则取逆二次插值步：
+           af(b)f(c)          bf(a)f(c)          cf(a)f(b)
+    s = --------------- + --------------- + ---------------
+        (fa-fb)(fa-fc)    (fb-fa)(fb-fc)    (fc-fa)(fc-fb)
+    2. 插值点不在b与(3b+c)/4之间，或步长未小于上上步步长的一半
+       时，改取二分步s = (b+c)/2。
+    3. 步长小于tol1时取tol1，tol1 = 2eps|b| + xtol + rtol|b|。
+
+    |c-b|/2 <= tol1或|f(b)| <= ftol时停止。兼具二分法的可靠性
+    与逆二次插值的超线性收敛，函数值计算次数不超过二分法的约
+    (log2((b-a)/tol))^2倍。
+
+    参考 R.P. Brent. Algorithms for Minimization without
+         Derivatives. Prentice-Hall, 1973. ss 4.
+------------------------------------------------------
+输入   :
+    fn      函数，定义为等式左侧部分，右侧为零
+    a, b    求解区间，f(a)f(b) <= 0
+    N       步数上限
+    xtol    x绝对误差上限
+    rtol    x相对误差上限
+    ftol    f误差上限
+输出   :
+    sol     解值
+    iter    迭代次数
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// RootBrent Brent法求解方程 f(x)=0 在区间[a, b]内的根
+func RootBrent(fn func(float64) float64, a, b float64, N int,
+	xtol, rtol, ftol float64) (float64, int, bool) {
+	/*
+		Brent法求解方程 f(x)=0 在区间[a, b]内的根
+		输入   :
+		    fn      函数，定义为等式左侧部分，右侧为零
+		    a, b    求解区间，f(a)f(b) <= 0
+		    N       步数上限
+		    xtol    x绝对误差上限
+		    rtol    x相对误差上限
+		    ftol    f误差上限
+		输出   :
+		    sol     解值
+		    iter    迭代次数
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断误差
+	if (xtol < 0.0) || (rtol < 0.0) || (ftol < 0.0) {
+		panic("Error in goNum.RootBrent: Tolerance less than zero")
+	}
+
+	var sol float64
+	var err bool = false
+	fa := fn(a)
+	fb := fn(b)
+	//判断在[a,b]区间是否有解
+	if fa*fb > 0 {
+		return sol, 0, err
+	}
+
+	c, fc := a, fa
+	d := b - a
+	e := d
+	for i := 0; i < N; i++ {
+		//保持有根区间[b, c]
+		if (fb > 0 && fc > 0) || (fb < 0 && fc < 0) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+		tol1 := 2.0*2.220446049250313e-16*math.Abs(b) + xtol + rtol*math.Abs(b)
+		xm := (c - b) / 2.0
+		//解出
+		if (math.Abs(xm) <= tol1) || (math.Abs(fb) <= ftol) {
+			err = true
+			return b, i, err
+		}
+		if (math.Abs(e) >= tol1) && (math.Abs(fa) > math.Abs(fb)) {
+			//割线或逆二次插值
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				p = 2.0 * xm * s
+				q = 1.0 - s
+			} else {
+				q = fa / fc
+				r := fb / fc
+				p = s * (2.0*xm*q*(q-r) - (b-a)*(r-1.0))
+				q = (q - 1.0) * (r - 1.0) * (s - 1.0)
+			}
+			if p > 0 {
+				q = -q
+			}
+			p = math.Abs(p)
+			if 2.0*p < math.Min(3.0*xm*q-math.Abs(tol1*q), math.Abs(e*q)) {
+				e = d
+				d = p / q
+			} else {
+				d = xm
+				e = d
+			}
+		} else {
+			//二分
+			d = xm
+			e = d
+		}
+		a, fa = b, fb
+		if math.Abs(d) > tol1 {
+			b += d
+		} else {
+			b += math.Copysign(tol1, xm)
+		}
+		fb = fn(b)
+	}
+	return b, N, err
+}
+
+//f(x) = cos(x) - x
+func fun84(x float64) float64 {
+	return math.Cos(x) - x
+}
+
+func BenchmarkRootBrent(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		goNum.RootBrent(fun84, 0.0, 1.0, 100, 1e-12, 1e-12, 0.0)
+	}
+}
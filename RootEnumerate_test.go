@@ -0,0 +1,302 @@
+// RootEnumerate_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    自适应搜索求方程 f(x)=0 在区间[a, b]内的全部实根
+理论：
+    1. 自适应采样：[a, b]先分为n等份，各等份至少二分4层（16个
+       子区间）。对子区间[x0, x1]取中点xm，满足下列任一条件时
+       二分该区间，并对两半递归判断，至区间宽度不大于xtol或
+       深度30：
+       a. f(xm)偏离线性插值：
+          |f(xm) - (f(x0)+f(x1))/2| > 0.25*(|f(x0)|+|f(x1)|)/2；
+       b. f(x0)、f(x1)同号而f(xm)异号（区间内至少两根）；
+       c. 过(x0, xm, x1)的抛物线p的顶点v在区间内且
+          |p(v)| < min(|f(x0)|, |f(x1)|)（|f|可能在区间内有极小值）。
+       因宽度或深度限制而未能继续二分、且其一个区间宽度范围内
+       未求得根或极点的子区间，可能遗漏根，此时err为false。
+    2. 由采样点序列确定有根区间：
+       a. 相邻采样点f异号，以RootBrent求根；所得x处|f(x)|大于ftol
+          且大于区间两端有限的|f|时（|f|随区间缩小而增大），f在x
+          处为极点（如tan(x)在pi/2处）而非根，舍去；
+       b. f(xi) = 0，xi即为根；
+       c. |f(xi)|为局部极小且f(x_(i-1))、f(xi)、f(x_(i+1))同号s，
+          以黄金分割法在[x_(i-1), x_(i+1)]上求s*f的极小值点x*：
+          s*f(x*) < 0时得两个有根区间，分别以RootBrent求根；
+          s*f(x*) <= ftol时x*为近二重根（二重根或相距很近的一对
+          根，f不变号）；否则无根。
+    3. 各根升序排列，相距不大于2xtol的根只保留一个。
+
+    与SearchByStep相比，步长随f的变化自适应加密，一般不需精确
+    预知最近两根间的距离，并可发现f不变号的二重根；但间距远小于
+    (b-a)/(16n)、且f在其附近变化平缓的根仍可能遗漏，宜适当增大n。
+------------------------------------------------------
+输入   :
+    fn      函数，定义为等式左侧部分，右侧为零
+    a, b    求解区间
+    n       初始等分数
+    xtol    x误差上限，亦为最小搜索区间宽度
+    ftol    近二重根判定的f误差上限
+输出   :
+    sol     全部根，升序
+    err     解出标志：false-未全部解出；true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+//黄金分割法求g在[a, b]上的极小值点，g < 0时提前结束
+func golden_RootEnumerate(g func(float64) float64, a, b, xtol float64) (float64, float64) {
+	r := (math.Sqrt(5.0) - 1.0) / 2.0
+	c := b - r*(b-a)
+	d := a + r*(b-a)
+	gc, gd := g(c), g(d)
+	for (b-a > xtol) && (gc >= 0.0) && (gd >= 0.0) {
+		if gc <= gd {
+			b, d, gd = d, c, gc
+			c = b - r*(b-a)
+			gc = g(c)
+		} else {
+			a, c, gc = c, d, gd
+			d = a + r*(b-a)
+			gd = g(d)
+		}
+	}
+	if gc <= gd {
+		return c, gc
+	}
+	return d, gd
+}
+
+// RootEnumerate 自适应搜索求方程 f(x)=0 在区间[a, b]内的全部实根
+func RootEnumerate(fn func(float64) float64, a, b float64, n int,
+	xtol, ftol float64) ([]float64, bool) {
+	/*
+		自适应搜索求方程 f(x)=0 在区间[a, b]内的全部实根
+		输入   :
+		    fn      函数，定义为等式左侧部分，右侧为零
+		    a, b    求解区间
+		    n       初始等分数
+		    xtol    x误差上限，亦为最小搜索区间宽度
+		    ftol    近二重根判定的f误差上限
+		输出   :
+		    sol     全部根，升序
+		    err     解出标志：false-未全部解出；true-全部解出
+	*/
+	//判断区间与等分数
+	if (b <= a) || (n < 1) {
+		panic("Error in goNum.RootEnumerate: Interval or subdivision number error")
+	}
+	if (xtol <= 0.0) || (ftol < 0.0) {
+		panic("Error in goNum.RootEnumerate: Tolerance error")
+	}
+
+	var err bool = true
+	sol := make([]float64, 0)
+
+	//1. 自适应采样
+	xs := []float64{a}
+	fs := []float64{fn(a)}
+	var short [][2]float64 //未能继续二分的子区间
+	var sample func(x0, f0, x1, f1 float64, depth int)
+	sample = func(x0, f0, x1, f1 float64, depth int) {
+		xm := (x0 + x1) / 2.0
+		fm := fn(xm)
+		split := depth < 4
+		if !split {
+			//a. 偏离线性插值
+			split = math.Abs(fm-(f0+f1)/2.0) > 0.25*(math.Abs(f0)+math.Abs(f1))/2.0
+			//b. 两端同号而中点异号
+			if (f0 != 0.0) && (f1 != 0.0) && (fm != 0.0) &&
+				(math.Signbit(f0) == math.Signbit(f1)) && (math.Signbit(fm) != math.Signbit(f0)) {
+				split = true
+			}
+			//c. 抛物线顶点
+			h := (x1 - x0) / 2.0
+			c2 := (f0 - 2.0*fm + f1) / (2.0 * h * h)
+			c1 := (f1 - f0) / (2.0 * h)
+			if c2 != 0.0 {
+				v := -c1 / (2.0 * c2)
+				pv := fm + c1*v + c2*v*v
+				if (math.Abs(v) < h) && (math.Abs(pv) < math.Min(math.Abs(f0), math.Abs(f1))) {
+					split = true
+				}
+			}
+		}
+		if split && ((x1-x0 <= xtol) || (depth >= 30)) {
+			short = append(short, [2]float64{x0, x1})
+			split = false
+		}
+		if split {
+			sample(x0, f0, xm, fm, depth+1)
+			sample(xm, fm, x1, f1, depth+1)
+			return
+		}
+		xs = append(xs, xm, x1)
+		fs = append(fs, fm, f1)
+	}
+	h := (b - a) / float64(n)
+	for i := 0; i < n; i++ {
+		x0 := a + float64(i)*h
+		x1 := a + float64(i+1)*h
+		if i == n-1 {
+			x1 = b
+		}
+		sample(x0, fs[len(fs)-1], x1, fn(x1), 0)
+	}
+
+	//2. 求根
+	var poles []float64 //舍去的极点
+	brent := func(x0, f0, x1, f1 float64) {
+		x, _, errtemp := goNum.RootBrent(fn, x0, x1, 200, xtol/2.0, 0.0, 0.0)
+		//极点：|f|随区间缩小而增大，端点处f非有限时该端点即为极点
+		fmax := 0.0
+		for _, v := range []float64{f0, f1} {
+			if !math.IsInf(v, 0) && !math.IsNaN(v) {
+				fmax = math.Max(fmax, math.Abs(v))
+			}
+		}
+		fx := math.Abs(fn(x))
+		if (fx > ftol) && !(fx <= fmax) {
+			poles = append(poles, x)
+			return
+		}
+		if errtemp != true {
+			err = false
+		}
+		sol = append(sol, x)
+	}
+	m := len(xs)
+	for i := 0; i < m; i++ {
+		if fs[i] == 0.0 {
+			sol = append(sol, xs[i])
+			continue
+		}
+		if (i < m-1) && (fs[i+1] != 0.0) && (math.Signbit(fs[i]) != math.Signbit(fs[i+1])) {
+			brent(xs[i], fs[i], xs[i+1], fs[i+1])
+			continue
+		}
+		//|f|的局部极小值
+		if (i == 0) || (i == m-1) {
+			continue
+		}
+		if (math.Abs(fs[i]) >= math.Abs(fs[i-1])) || (math.Abs(fs[i]) >= math.Abs(fs[i+1])) ||
+			(math.Signbit(fs[i-1]) != math.Signbit(fs[i])) || (math.Signbit(fs[i+1]) != math.Signbit(fs[i])) {
+			continue
+		}
+		s := math.Copysign(1.0, fs[i])
+		x, gx := golden_RootEnumerate(func(x float64) float64 { return s * fn(x) },
+			xs[i-1], xs[i+1], xtol)
+		switch {
+		case gx < 0.0:
+			brent(xs[i-1], fs[i-1], x, s*gx)
+			brent(x, s*gx, xs[i+1], fs[i+1])
+		case gx <= ftol:
+			sol = append(sol, x)
+		}
+	}
+
+	//3. 排序去重
+	sort.Float64s(sol)
+	k := 0
+	for i := 0; i < len(sol); i++ {
+		if (k > 0) && (sol[i]-sol[k-1] <= 2.0*xtol) {
+			continue
+		}
+		sol[k] = sol[i]
+		k++
+	}
+	sol = sol[:k]
+
+	//4. 未能继续二分且一个区间宽度内未求得根的子区间（二重根、极点
+	//附近的子区间因f非线性亦无法继续二分，视为已解出）
+	for _, v := range short {
+		found := false
+		w := v[1] - v[0] + xtol
+		for _, x := range append(poles, sol...) {
+			if (x >= v[0]-w) && (x <= v[1]+w) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			err = false
+		}
+	}
+	return sol, err
+}
+
+//f(x) = (x-1)^2(x+2)sin(5x)，含二重根x = 1
+func fun86(x float64) float64 {
+	return (x - 1.0) * (x - 1.0) * (x + 2.0) * math.Sin(5.0*x)
+}
+
+//一个初始等分内含多个根
+func TestRootEnumerate(t *testing.T) {
+	//sin(20x)在[0, 3]上的根k*pi/20, k = 0, ..., 19
+	for _, n := range []int{1, 3, 6} {
+		sol, err := goNum.RootEnumerate(func(x float64) float64 { return math.Sin(20.0 * x) },
+			0.0, 3.0, n, 1e-10, 1e-12)
+		if !err || (len(sol) != 20) {
+			t.Fatalf("sin(20x), n = %d: %d roots, err = %v", n, len(sol), err)
+		}
+		for k := range sol {
+			if math.Abs(sol[k]-float64(k)*math.Pi/20.0) > 1e-9 {
+				t.Errorf("sin(20x), n = %d: root %d = %v", n, k, sol[k])
+			}
+		}
+	}
+	//(x-0.1)(x-0.2)...(x-0.6)在[0, 1]上，n = 1
+	fn := func(x float64) float64 {
+		sol := 1.0
+		for k := 1; k < 7; k++ {
+			sol *= x - 0.1*float64(k)
+		}
+		return sol
+	}
+	sol, err := goNum.RootEnumerate(fn, 0.0, 1.0, 1, 1e-10, 1e-12)
+	if !err || (len(sol) != 6) {
+		t.Fatalf("product: roots %v, err = %v", sol, err)
+	}
+	for k := range sol {
+		if math.Abs(sol[k]-0.1*float64(k+1)) > 1e-9 {
+			t.Errorf("product: root %d = %v", k, sol[k])
+		}
+	}
+	//二重根x = 1
+	sol, err = goNum.RootEnumerate(fun86, -3.0, 3.0, 1, 1e-10, 1e-14)
+	if !err || (len(sol) != 11) {
+		t.Errorf("fun86: roots %v, err = %v", sol, err)
+	}
+	//极点pi/2、3pi/2、5pi/2不是根
+	sol, err = goNum.RootEnumerate(math.Tan, 0.0, 10.0, 10, 1e-10, 1e-12)
+	if !err || (len(sol) != 4) {
+		t.Fatalf("tan: roots %v, err = %v", sol, err)
+	}
+	for k := range sol {
+		if math.Abs(sol[k]-float64(k)*math.Pi) > 1e-9 {
+			t.Errorf("tan: root %d = %v", k, sol[k])
+		}
+	}
+	sol, err = goNum.RootEnumerate(func(x float64) float64 { return (x - 0.3) / (x - 0.5) }, 0.0, 1.0, 1, 1e-10, 1e-12)
+	if !err || (len(sol) != 1) || (math.Abs(sol[0]-0.3) > 1e-9) {
+		t.Errorf("(x-0.3)/(x-0.5): roots %v, err = %v", sol, err)
+	}
+}
+
+func BenchmarkRootEnumerate(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		goNum.RootEnumerate(fun86, -3.0, 3.0, 10, 1e-10, 1e-14)
+	}
+}
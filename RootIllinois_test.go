@@ -0,0 +1,117 @@
+// RootIllinois_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    Illinois修正试位法求解方程 f(x)=0 在区间[a, b]内的根
+理论：
+    有根区间[a, b]，f(a)f(b) < 0，试位点：
+         a*f(b) - b*f(a)
+    c = -----------------
+           f(b) - f(a)
+    f(c)f(b) < 0时a = b，否则保留a且f(a) = f(a)/2，再取b = c。
+    普通试位法中一端点长期不动时仅线性收敛，将该端点函数值
+    减半可使其迅速移动，收敛阶约1.442。
+
+    |b-a| <= 2(xtol + rtol|c|)或|f(c)| <= ftol时停止。
+    在高重根附近f(a)的减半与插值点的移动同样缓慢，宜以ftol控制
+    或改用RootBrent、RootTOMS748。
+
+    参考 M. Dowell and P. Jarratt. A modified regula falsi
+         method for computing the root of an equation. BIT,
+         1971, 11: 168-174.
+------------------------------------------------------
+输入   :
+    fn      函数，定义为等式左侧部分，右侧为零
+    a, b    求解区间，f(a)f(b) <= 0
+    N       步数上限
+    xtol    x绝对误差上限
+    rtol    x相对误差上限
+    ftol    f误差上限
+输出   :
+    sol     解值
+    iter    迭代次数
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// RootIllinois Illinois修正试位法求解方程 f(x)=0 在区间[a, b]内的根
+func RootIllinois(fn func(float64) float64, a, b float64, N int,
+	xtol, rtol, ftol float64) (float64, int, bool) {
+	/*
+		Illinois修正试位法求解方程 f(x)=0 在区间[a, b]内的根
+		输入   :
+		    fn      函数，定义为等式左侧部分，右侧为零
+		    a, b    求解区间，f(a)f(b) <= 0
+		    N       步数上限
+		    xtol    x绝对误差上限
+		    rtol    x相对误差上限
+		    ftol    f误差上限
+		输出   :
+		    sol     解值
+		    iter    迭代次数
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断误差
+	if (xtol < 0.0) || (rtol < 0.0) || (ftol < 0.0) {
+		panic("Error in goNum.RootIllinois: Tolerance less than zero")
+	}
+
+	var sol float64
+	var err bool = false
+	fa := fn(a)
+	fb := fn(b)
+	//判断在[a,b]区间是否有解
+	if fa*fb > 0 {
+		return sol, 0, err
+	}
+	if math.Abs(fa) <= ftol {
+		err = true
+		return a, 0, err
+	}
+	if math.Abs(fb) <= ftol {
+		err = true
+		return b, 0, err
+	}
+
+	for i := 0; i < N; i++ {
+		sol = (a*fb - b*fa) / (fb - fa)
+		fc := fn(sol)
+		//解出
+		if math.Abs(fc) <= ftol {
+			err = true
+			return sol, i + 1, err
+		}
+		//重置区间边界
+		if math.Signbit(fc) != math.Signbit(fb) {
+			a, fa = b, fb
+		} else {
+			fa /= 2.0
+		}
+		b, fb = sol, fc
+		if math.Abs(b-a) <= 2.0*(xtol+rtol*math.Abs(sol)) {
+			err = true
+			return sol, i + 1, err
+		}
+	}
+	return sol, N, err
+}
+
+func BenchmarkRootIllinois(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		goNum.RootIllinois(fun84, 0.0, 1.0, 100, 1e-12, 1e-12, 0.0)
+	}
+}
@@ -0,0 +1,126 @@
+// RootRidders
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    Ridders法求解方程 f(x)=0 在区间[a, b]内的根
+理论：
+    有根区间[x1, x2]，f(x1)f(x2) < 0，取中点x3 = (x1+x2)/2，
+    以指数函数因子e^(Qx)使f(x1)、f(x3)、f(x2)对应的三点共线，
+    对其作线性插值：
+                                      f(x3)
+    x4 = x3 + (x3-x1)sign(f1-f2)---------------------
+                                 sqrt(f3^2 - f1*f2)
+    x4必在[x1, x2]内，再由x3、x4及原端点中选取新的有根区间。
+
+    |x2-x1| <= 2(xtol + rtol|x4|)、相邻两步的x4之差不超过
+    xtol + rtol|x4|（此时不再计算f(x4)）或|f(x4)| <= ftol时停止。
+    区间可能长期只从一侧收缩，故须同时判断x4的变化。每步计算
+    两次函数值，收敛阶为sqrt(2)（每次函数值计算）。
+
+    参考 C.J.F. Ridders. A new algorithm for computing a single
+         root of a real continuous function. IEEE Trans.
+         Circuits Syst., 1979, 26(11): 979-980.
+------------------------------------------------------
+输入   :
+    fn      函数，定义为等式左侧部分，右侧为零
+    a, b    求解区间，f(a)f(b) <= 0
+    N       步数上限
+    xtol    x绝对误差上限
+    rtol    x相对误差上限
+    ftol    f误差上限
+输出   :
+    sol     解值
+    iter    迭代次数
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+// RootRidders Ridders法求解方程 f(x)=0 在区间[a, b]内的根
+func RootRidders(fn func(float64) float64, a, b float64, N int,
+	xtol, rtol, ftol float64) (float64, int, bool) {
+	/*
+		Ridders法求解方程 f(x)=0 在区间[a, b]内的根
+		输入   :
+		    fn      函数，定义为等式左侧部分，右侧为零
+		    a, b    求解区间，f(a)f(b) <= 0
+		    N       步数上限
+		    xtol    x绝对误差上限
+		    rtol    x相对误差上限
+		    ftol    f误差上限
+		输出   :
+		    sol     解值
+		    iter    迭代次数
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断误差
+	if (xtol < 0.0) || (rtol < 0.0) || (ftol < 0.0) {
+		panic("Error in goNum.RootRidders: Tolerance less than zero")
+	}
+
+	var sol float64
+	var err bool = false
+	fa := fn(a)
+	fb := fn(b)
+	//判断在[a,b]区间是否有解
+	if fa*fb > 0 {
+		return sol, 0, err
+	}
+	if math.Abs(fa) <= ftol {
+		err = true
+		return a, 0, err
+	}
+	if math.Abs(fb) <= ftol {
+		err = true
+		return b, 0, err
+	}
+
+	sol = (a + b) / 2.0
+	xold := math.Inf(1) //上一步的x4
+	for i := 0; i < N; i++ {
+		c := (a + b) / 2.0
+		fc := fn(c)
+		s := math.Sqrt(fc*fc - fa*fb)
+		if s == 0.0 {
+			return sol, i, err
+		}
+		sol = c + (c-a)*math.Copysign(1.0, fa-fb)*fc/s
+		//相邻两步的估计值足够接近
+		if math.Abs(sol-xold) <= xtol+rtol*math.Abs(sol) {
+			err = true
+			return sol, i + 1, err
+		}
+		xold = sol
+		fsol := fn(sol)
+		//解出
+		if math.Abs(fsol) <= ftol {
+			err = true
+			return sol, i + 1, err
+		}
+		//重置区间边界
+		switch {
+		case math.Signbit(fc) != math.Signbit(fsol):
+			a, fa = c, fc
+			b, fb = sol, fsol
+		case math.Signbit(fa) != math.Signbit(fsol):
+			b, fb = sol, fsol
+		default:
+			a, fa = sol, fsol
+		}
+		if math.Abs(b-a) <= 2.0*(xtol+rtol*math.Abs(sol)) {
+			err = true
+			return sol, i + 1, err
+		}
+	}
+	return sol, N, err
+}
@@ -0,0 +1,232 @@
+// RootTOMS748_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    Alefeld-Potra-Shi法（TOMS 748）求解方程 f(x)=0 在区间[a, b]内的根
+理论：
+    有根区间[a, b]，f(a)f(b) < 0，d、e为最近两次被舍去的点。
+    每次迭代：
+    1. 取两次插值步：四点函数值互不接近时，取过(a, b, d, e)的
+       逆三次插值零点；否则（或该点不在区间内）以过(a, b, d)的
+       二次Newton插值多项式作k次（k = 2, 3）Newton迭代。每步求
+       得c后以c重新划分有根区间。
+    2. 双倍割线步：u为|f|较小的端点，
+       c = u - 2f(u)(b-a)/(f(b)-f(a))
+       |c-u| > (b-a)/2时取中点。
+    3. 区间宽度未缩小至迭代前的一半时，另取一次二分步。
+
+    b-a <= 2(xtol + rtol|x|)或|f(x)| <= ftol时停止，x为|f|较小
+    的端点。每次迭代约3次函数值计算，渐近效率指数1.6529，在
+    最坏情况下不劣于二分法。
+
+    参考 G.E. Alefeld, F.A. Potra and Y. Shi. Algorithm 748:
+         enclosing zeros of continuous functions. ACM Trans.
+         Math. Softw., 1995, 21(3): 327-344.
+------------------------------------------------------
+输入   :
+    fn      函数，定义为等式左侧部分，右侧为零
+    a, b    求解区间，f(a)f(b) <= 0
+    N       步数上限
+    xtol    x绝对误差上限
+    rtol    x相对误差上限
+    ftol    f误差上限
+输出   :
+    sol     解值
+    iter    迭代次数
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+//过(fa, a)、(fb, b)、(fc, c)、(fd, d)的逆三次插值多项式在0处的值
+func inverseCubic_RootTOMS748(x, f []float64) float64 {
+	var sol float64
+	for i := 0; i < 4; i++ {
+		temp0 := x[i]
+		for j := 0; j < 4; j++ {
+			if j != i {
+				temp0 *= f[j] / (f[j] - f[i])
+			}
+		}
+		sol += temp0
+	}
+	return sol
+}
+
+//过(a, fa)、(b, fb)、(d, fd)的二次Newton插值多项式的零点，k次Newton迭代
+func newtonQuadratic_RootTOMS748(a, b, d, fa, fb, fd float64, k int) float64 {
+	B := (fb - fa) / (b - a)
+	A := ((fd-fb)/(d-b) - B) / (d - a)
+	if A == 0.0 {
+		return a - fa/B
+	}
+	r := b
+	if A*fa > 0 {
+		r = a
+	}
+	for i := 0; i < k; i++ {
+		r1 := r - ((A*(r-b)+B)*(r-a)+fa)/(B+A*(2.0*r-a-b))
+		if !(r1 > a && r1 < b) {
+			if r > a && r < b {
+				return r
+			}
+			return (a + b) / 2.0
+		}
+		r = r1
+	}
+	return r
+}
+
+// RootTOMS748 Alefeld-Potra-Shi法（TOMS 748）求解方程 f(x)=0 在区间[a, b]内的根
+func RootTOMS748(fn func(float64) float64, a, b float64, N int,
+	xtol, rtol, ftol float64) (float64, int, bool) {
+	/*
+		Alefeld-Potra-Shi法（TOMS 748）求解方程 f(x)=0 在区间[a, b]内的根
+		输入   :
+		    fn      函数，定义为等式左侧部分，右侧为零
+		    a, b    求解区间，f(a)f(b) <= 0
+		    N       步数上限
+		    xtol    x绝对误差上限
+		    rtol    x相对误差上限
+		    ftol    f误差上限
+		输出   :
+		    sol     解值
+		    iter    迭代次数
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断误差
+	if (xtol < 0.0) || (rtol < 0.0) || (ftol < 0.0) {
+		panic("Error in goNum.RootTOMS748: Tolerance less than zero")
+	}
+
+	const eps = 2.220446049250313e-16
+	var sol float64
+	var err bool = false
+	if a > b {
+		a, b = b, a
+	}
+	fa := fn(a)
+	fb := fn(b)
+	//判断在[a,b]区间是否有解
+	if fa*fb > 0 {
+		return sol, 0, err
+	}
+
+	//|f|较小的端点
+	best := func() (float64, float64) {
+		if math.Abs(fa) < math.Abs(fb) {
+			return a, fa
+		}
+		return b, fb
+	}
+	//以c重新划分有根区间，返回被舍去的端点
+	bracket := func(c, fc float64) (float64, float64) {
+		if math.Signbit(fa) == math.Signbit(fc) {
+			a, fa, c, fc = c, fc, a, fa
+		} else {
+			b, fb, c, fc = c, fc, b, fb
+		}
+		return c, fc
+	}
+	//收敛判断
+	done := func() bool {
+		x, fx := best()
+		return (math.Abs(fx) <= ftol) || (b-a <= 2.0*(xtol+rtol*math.Abs(x)))
+	}
+	if done() {
+		err = true
+		sol, _ = best()
+		return sol, 0, err
+	}
+
+	//第一步取割线步
+	c := a - fa*(b-a)/(fb-fa)
+	if !(c > a && c < b) {
+		c = (a + b) / 2.0
+	}
+	d, fd := bracket(c, fn(c))
+	e, fe := math.NaN(), math.NaN()
+	for i := 0; i < N; i++ {
+		if done() {
+			err = true
+			sol, _ = best()
+			return sol, i, err
+		}
+		width := b - a
+
+		//1. 两次插值步
+		for k := 2; k < 4; k++ {
+			c = math.NaN()
+			f := []float64{fa, fb, fd, fe}
+			distinct := !math.IsNaN(fe)
+			for p := 0; p < 4 && distinct; p++ {
+				for q := p + 1; q < 4; q++ {
+					if math.Abs(f[p]-f[q]) <= 32.0*eps {
+						distinct = false
+					}
+				}
+			}
+			if distinct {
+				c = inverseCubic_RootTOMS748([]float64{a, b, d, e}, f)
+			}
+			if !(c > a && c < b) {
+				c = newtonQuadratic_RootTOMS748(a, b, d, fa, fb, fd, k)
+			}
+			fc := fn(c)
+			e, fe = d, fd
+			d, fd = bracket(c, fc)
+			if done() {
+				err = true
+				sol, _ = best()
+				return sol, i + 1, err
+			}
+		}
+
+		//2. 双倍割线步
+		u, fu := best()
+		c = u - 2.0*fu*(b-a)/(fb-fa)
+		if math.Abs(c-u) > (b-a)/2.0 {
+			c = (a + b) / 2.0
+		} else if math.Abs(c-u) <= eps*math.Abs(u) {
+			//c与u重合时沿区间内侧移动
+			temp0 := eps*math.Abs(u) + xtol + rtol*math.Abs(u)
+			if u == b {
+				temp0 = -temp0
+			}
+			c = u + temp0
+			if !(c > a && c < b) {
+				c = (a + b) / 2.0
+			}
+		}
+		e, fe = d, fd
+		d, fd = bracket(c, fn(c))
+
+		//3. 区间缩小不足时二分
+		if (b-a > width/2.0) && !done() {
+			c = (a + b) / 2.0
+			e, fe = d, fd
+			d, fd = bracket(c, fn(c))
+		}
+	}
+	sol, _ = best()
+	return sol, N, err
+}
+
+func BenchmarkRootTOMS748(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		goNum.RootTOMS748(fun84, 0.0, 1.0, 100, 1e-12, 1e-12, 0.0)
+	}
+}
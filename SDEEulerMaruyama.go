@@ -0,0 +1,167 @@
+// SDEEulerMaruyama
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    Euler-Maruyama法求解随机微分方程组
+理论：
+    对于fn维随机微分方程组，m维Wiener过程W：
+    dy = a(t, y)dt + B(t, y)dW, B为fnxm扩散矩阵
+
+    Ito型：
+    y_(n+1) = yn + a(tn, yn)h + B(tn, yn)dWn
+    dWn ~ N(0, h*I)，各分量相互独立
+
+    Stratonovich型先转换为等价的Ito型漂移项：
+                      1   m   fn
+    a_i(Ito) = a_i + --- Sum Sum B_kj*dB_ij/dy_k
+                      2  j=1 k=1
+    其中方向导数以差分近似。
+
+    强收敛阶0.5，弱收敛阶1.0
+
+    参考 P.E. Kloeden and E. Platen. Numerical Solution of
+         Stochastic Differential Equations. Springer, 1992.
+         ss 10.2.
+------------------------------------------------------
+输入   :
+    fun     漂移项a的第i个分量(计算变量值向量[t y1 ... yfn]', i)
+    gfun    扩散矩阵B的第(i, j)个元素(计算变量值向量, i, j)
+    x0      初值向量，(fn+1)x1，一个t，fn个因变量
+    tend    终止t
+    fn      方程个数
+    m       Wiener过程维数
+    n       积分步数
+    seed    随机数种子
+    ito     true-Ito型；false-Stratonovich型
+输出   :
+    sol     解矩阵，(fn+1)x(n+1)，第一行为t
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+	"math/rand"
+)
+
+//Wiener增量，各分量~N(0, h)
+func wienerInc_SDEEulerMaruyama(r *rand.Rand, m int, h float64) []float64 {
+	dW := make([]float64, m)
+	sqrth := math.Sqrt(h)
+	for j := 0; j < m; j++ {
+		dW[j] = sqrth * r.NormFloat64()
+	}
+	return dW
+}
+
+//扩散矩阵B(t, y)，fnxm
+func gMatrix_SDEEulerMaruyama(gfun func(Matrix, int, int) float64, xy Matrix, fn, m int) Matrix {
+	B := ZeroMatrix(fn, m)
+	for i := 0; i < fn; i++ {
+		for j := 0; j < m; j++ {
+			B.SetMatrix(i, j, gfun(xy, i, j))
+		}
+	}
+	return B
+}
+
+//扩散矩阵第j2列沿方向v的方向导数(差分近似)，即Sum_k v_k*dB_(i,j2)/dy_k
+func dirDeriv_SDEEulerMaruyama(gfun func(Matrix, int, int) float64, xy Matrix,
+	v []float64, fn, j2 int) []float64 {
+	sol := make([]float64, fn)
+	vmax, ymax := 0.0, 1.0
+	for k := 0; k < fn; k++ {
+		vmax = math.Max(vmax, math.Abs(v[k]))
+		ymax = math.Max(ymax, math.Abs(xy.Data[k+1]))
+	}
+	if vmax == 0.0 {
+		return sol
+	}
+	eps := math.Sqrt(2.220446049250313e-16) * ymax / vmax
+	xyt := ZeroMatrix(fn+1, 1)
+	xyt.Data[0] = xy.Data[0]
+	for k := 0; k < fn; k++ {
+		xyt.Data[k+1] = xy.Data[k+1] + eps*v[k]
+	}
+	for i := 0; i < fn; i++ {
+		sol[i] = (gfun(xyt, i, j2) - gfun(xy, i, j2)) / eps
+	}
+	return sol
+}
+
+// SDEEulerMaruyama Euler-Maruyama法求解随机微分方程组
+func SDEEulerMaruyama(fun func(Matrix, int) float64, gfun func(Matrix, int, int) float64,
+	x0 Matrix, tend float64, fn, m, n int, seed int64, ito bool) (Matrix, bool) {
+	/*
+		Euler-Maruyama法求解随机微分方程组
+		输入   :
+		    fun     漂移项a的第i个分量(计算变量值向量[t y1 ... yfn]', i)
+		    gfun    扩散矩阵B的第(i, j)个元素(计算变量值向量, i, j)
+		    x0      初值向量，(fn+1)x1，一个t，fn个因变量
+		    tend    终止t
+		    fn      方程个数
+		    m       Wiener过程维数
+		    n       积分步数
+		    seed    随机数种子
+		    ito     true-Ito型；false-Stratonovich型
+		输出   :
+		    sol     解矩阵，(fn+1)x(n+1)，第一行为t
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断方程个数是否对应初值个数
+	if x0.Rows != fn+1 {
+		panic("Error in goNum.SDEEulerMaruyama: Quantities of x0 and fn+1 are not equal")
+	}
+	//判断n与m
+	if (n < 1) || (m < 1) {
+		panic("Error in goNum.SDEEulerMaruyama: n or m less than one")
+	}
+
+	sol := ZeroMatrix(fn+1, n+1)
+	var err bool = false
+	h := (tend - x0.Data[0]) / float64(n) //步长
+	r := rand.New(rand.NewSource(seed))
+
+	//把初值赋给sol
+	for i := 0; i < fn+1; i++ {
+		sol.SetMatrix(i, 0, x0.Data[i])
+	}
+
+	temp0 := ZeroMatrix(fn+1, 1)
+	for k := 1; k < n+1; k++ {
+		for i := 0; i < fn+1; i++ {
+			temp0.Data[i] = sol.GetFromMatrix(i, k-1)
+		}
+		B := gMatrix_SDEEulerMaruyama(gfun, temp0, fn, m)
+		dW := wienerInc_SDEEulerMaruyama(r, m, h)
+		//Stratonovich漂移修正
+		corr := make([]float64, fn)
+		if !ito {
+			for j := 0; j < m; j++ {
+				dB := dirDeriv_SDEEulerMaruyama(gfun, temp0, B.ColumnOfMatrix(j), fn, j)
+				for i := 0; i < fn; i++ {
+					corr[i] += dB[i] / 2.0
+				}
+			}
+		}
+
+		sol.SetMatrix(0, k, temp0.Data[0]+h) //tk
+		for i := 0; i < fn; i++ {
+			temp1 := temp0.Data[i+1] + (fun(temp0, i)+corr[i])*h
+			for j := 0; j < m; j++ {
+				temp1 += B.GetFromMatrix(i, j) * dW[j]
+			}
+			sol.SetMatrix(i+1, k, temp1)
+		}
+	}
+
+	err = true
+	return sol, err
+}
@@ -0,0 +1,129 @@
+// SDEMilstein
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    Milstein法求解随机微分方程组
+理论：
+    对于fn维随机微分方程组，m维Wiener过程W：
+    dy = a(t, y)dt + B(t, y)dW, B为fnxm扩散矩阵
+
+                                m              m    m
+    y_(n+1) = yn + a(tn, yn)h + Sum Bj*dWj  + Sum  Sum (L^j1 Bj2)*I(j1,j2)
+                               j=1            j1=1 j2=1
+              fn
+    L^j1  =  Sum B_(k,j1)*d/dy_k，以方向差分近似
+             k=1
+
+    多重随机积分近似为：
+    Ito型：          I(j1,j2) = (dWj1*dWj2 - h*delta(j1,j2))/2
+    Stratonovich型： J(j1,j2) = dWj1*dWj2/2
+    对标量噪声、对角噪声及可交换噪声(L^j1 Bj2 = L^j2 Bj1)为精确
+    表示，此时强收敛阶1.0；一般非交换噪声忽略了Levy面积项。
+
+    参考 P.E. Kloeden and E. Platen. Numerical Solution of
+         Stochastic Differential Equations. Springer, 1992.
+         ss 10.3.
+------------------------------------------------------
+输入   :
+    fun     漂移项a的第i个分量(计算变量值向量[t y1 ... yfn]', i)
+    gfun    扩散矩阵B的第(i, j)个元素(计算变量值向量, i, j)
+    x0      初值向量，(fn+1)x1，一个t，fn个因变量
+    tend    终止t
+    fn      方程个数
+    m       Wiener过程维数
+    n       积分步数
+    seed    随机数种子
+    ito     true-Ito型；false-Stratonovich型
+输出   :
+    sol     解矩阵，(fn+1)x(n+1)，第一行为t
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math/rand"
+)
+
+// SDEMilstein Milstein法求解随机微分方程组
+func SDEMilstein(fun func(Matrix, int) float64, gfun func(Matrix, int, int) float64,
+	x0 Matrix, tend float64, fn, m, n int, seed int64, ito bool) (Matrix, bool) {
+	/*
+		Milstein法求解随机微分方程组
+		输入   :
+		    fun     漂移项a的第i个分量(计算变量值向量[t y1 ... yfn]', i)
+		    gfun    扩散矩阵B的第(i, j)个元素(计算变量值向量, i, j)
+		    x0      初值向量，(fn+1)x1，一个t，fn个因变量
+		    tend    终止t
+		    fn      方程个数
+		    m       Wiener过程维数
+		    n       积分步数
+		    seed    随机数种子
+		    ito     true-Ito型；false-Stratonovich型
+		输出   :
+		    sol     解矩阵，(fn+1)x(n+1)，第一行为t
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断方程个数是否对应初值个数
+	if x0.Rows != fn+1 {
+		panic("Error in goNum.SDEMilstein: Quantities of x0 and fn+1 are not equal")
+	}
+	//判断n与m
+	if (n < 1) || (m < 1) {
+		panic("Error in goNum.SDEMilstein: n or m less than one")
+	}
+
+	sol := ZeroMatrix(fn+1, n+1)
+	var err bool = false
+	h := (tend - x0.Data[0]) / float64(n) //步长
+	r := rand.New(rand.NewSource(seed))
+
+	//把初值赋给sol
+	for i := 0; i < fn+1; i++ {
+		sol.SetMatrix(i, 0, x0.Data[i])
+	}
+
+	temp0 := ZeroMatrix(fn+1, 1)
+	for k := 1; k < n+1; k++ {
+		for i := 0; i < fn+1; i++ {
+			temp0.Data[i] = sol.GetFromMatrix(i, k-1)
+		}
+		B := gMatrix_SDEEulerMaruyama(gfun, temp0, fn, m)
+		dW := wienerInc_SDEEulerMaruyama(r, m, h)
+
+		sol.SetMatrix(0, k, temp0.Data[0]+h) //tk
+		soltemp := make([]float64, fn)
+		for i := 0; i < fn; i++ {
+			soltemp[i] = temp0.Data[i+1] + fun(temp0, i)*h
+			for j := 0; j < m; j++ {
+				soltemp[i] += B.GetFromMatrix(i, j) * dW[j]
+			}
+		}
+		//Milstein修正项
+		for j1 := 0; j1 < m; j1++ {
+			v := B.ColumnOfMatrix(j1)
+			for j2 := 0; j2 < m; j2++ {
+				I12 := dW[j1] * dW[j2] / 2.0
+				if ito && (j1 == j2) {
+					I12 -= h / 2.0
+				}
+				dB := dirDeriv_SDEEulerMaruyama(gfun, temp0, v, fn, j2)
+				for i := 0; i < fn; i++ {
+					soltemp[i] += dB[i] * I12
+				}
+			}
+		}
+		for i := 0; i < fn; i++ {
+			sol.SetMatrix(i+1, k, soltemp[i])
+		}
+	}
+
+	err = true
+	return sol, err
+}
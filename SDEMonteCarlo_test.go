@@ -0,0 +1,176 @@
+// SDEMonteCarlo_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    随机微分方程组的Monte Carlo集合模拟（并行）
+理论：
+    以不同随机数种子seed+k (k=0,1,...,runs-1)重复求解随机微分
+    方程组runs次，统计每一时间层上各分量的样本均值与样本方差：
+            1  runs
+    E[y] = --- Sum y^(k)
+           runs k=1
+                 1    runs
+    Var[y] = ------- Sum (y^(k)-E[y])^2
+             runs-1  k=1
+
+    runs次求解按k mod workers分配至workers个goroutine，各自以
+    Welford算法累加，最后按Chan并行合并公式汇总。对同一workers，
+    结果与调度顺序无关，可重复。
+
+    参考 T.F. Chan, G.H. Golub and R.J. LeVeque. Updating
+         formulae and a pairwise algorithm for computing sample
+         variances. Stanford, 1979.
+------------------------------------------------------
+输入   :
+    solver  单次求解函数(随机数种子)，返回(fn+1)x(n+1)解矩阵，
+            如SDEEulerMaruyama、SDEMilstein、SDERungeKutta的包装
+    runs    模拟次数
+    workers 并行goroutine数量
+    seed    初始随机数种子
+输出   :
+    mean    均值矩阵，(fn+1)x(n+1)，第一行为t
+    vari    方差矩阵，(fn+1)x(n+1)，第一行为t
+    err     解出标志：false-存在未解出的模拟；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// SDEMonteCarlo 随机微分方程组的Monte Carlo集合模拟（并行）
+func SDEMonteCarlo(solver func(int64) (goNum.Matrix, bool), runs, workers int, seed int64) (goNum.Matrix, goNum.Matrix, bool) {
+	/*
+		随机微分方程组的Monte Carlo集合模拟（并行）
+		输入   :
+		    solver  单次求解函数(随机数种子)，返回(fn+1)x(n+1)解矩阵，
+		            如SDEEulerMaruyama、SDEMilstein、SDERungeKutta的包装
+		    runs    模拟次数
+		    workers 并行goroutine数量
+		    seed    初始随机数种子
+		输出   :
+		    mean    均值矩阵，(fn+1)x(n+1)，第一行为t
+		    vari    方差矩阵，(fn+1)x(n+1)，第一行为t
+		    err     解出标志：false-存在未解出的模拟；
+		                     true-全部解出
+	*/
+	//判断runs与workers
+	if runs < 2 {
+		panic("Error in goNum.SDEMonteCarlo: runs less than two")
+	}
+	if workers < 1 {
+		panic("Error in goNum.SDEMonteCarlo: workers less than one")
+	}
+	if workers > runs {
+		workers = runs
+	}
+
+	//各goroutine的Welford累加量
+	counts := make([]int, workers)
+	means := make([]goNum.Matrix, workers)
+	m2s := make([]goNum.Matrix, workers)
+	oks := make([]bool, workers)
+	sizeoks := make([]bool, workers) //路径尺寸一致，在调用goroutine中判断
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			oks[w] = true
+			sizeoks[w] = true
+			for k := w; k < runs; k += workers {
+				path, ok := solver(seed + int64(k))
+				if !ok {
+					oks[w] = false
+				}
+				if counts[w] == 0 {
+					means[w] = goNum.ZeroMatrix(path.Rows, path.Columns)
+					m2s[w] = goNum.ZeroMatrix(path.Rows, path.Columns)
+				} else if (path.Rows != means[w].Rows) || (path.Columns != means[w].Columns) {
+					sizeoks[w] = false
+					break
+				}
+				counts[w]++
+				cnt := float64(counts[w])
+				for i := range path.Data {
+					delta := path.Data[i] - means[w].Data[i]
+					means[w].Data[i] += delta / cnt
+					m2s[w].Data[i] += delta * (path.Data[i] - means[w].Data[i])
+				}
+			}
+			wg.Done()
+		}(w)
+	}
+	wg.Wait()
+
+	//判断路径尺寸
+	for w := 0; w < workers; w++ {
+		if !sizeoks[w] || (means[w].Rows != means[0].Rows) || (means[w].Columns != means[0].Columns) {
+			panic("Error in goNum.SDEMonteCarlo: Sizes of paths are not equal")
+		}
+	}
+
+	//合并
+	var err bool = true
+	mean := means[0]
+	m2 := m2s[0]
+	cnt := float64(counts[0])
+	for w := 0; w < workers; w++ {
+		if !oks[w] {
+			err = false
+		}
+		if w == 0 {
+			continue
+		}
+		cw := float64(counts[w])
+		for i := range mean.Data {
+			delta := means[w].Data[i] - mean.Data[i]
+			mean.Data[i] += delta * cw / (cnt + cw)
+			m2.Data[i] += m2s[w].Data[i] + delta*delta*cnt*cw/(cnt+cw)
+		}
+		cnt += cw
+	}
+
+	vari := goNum.NumProductMatrix(m2, 1.0/(cnt-1.0))
+	//第一行为t
+	for j := 0; j < mean.Columns; j++ {
+		vari.SetMatrix(0, j, mean.GetFromMatrix(0, j))
+	}
+	return mean, vari, err
+}
+
+func fun65(seed int64) (goNum.Matrix, bool) {
+	x65 := goNum.NewMatrix(2, 1, []float64{0.0, 1.0})
+	return goNum.SDEMilstein(fun62, fun62g, x65, 1.0, 1, 1, 200, seed, true)
+}
+
+func BenchmarkSDEMonteCarlo(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		goNum.SDEMonteCarlo(fun65, 1000, 4, 0)
+	}
+}
+
+func TestSDEMonteCarloSizes(t *testing.T) {
+	//路径尺寸不一致时在调用goroutine中panic，可由调用者recover
+	for _, workers := range []int{1, 2} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("workers = %d: no panic for unequal sizes", workers)
+				}
+			}()
+			goNum.SDEMonteCarlo(func(seed int64) (goNum.Matrix, bool) {
+				return goNum.ZeroMatrix(2, 3+int(seed%2)), true
+			}, 8, workers, 0)
+		}()
+	}
+}
@@ -0,0 +1,159 @@
+// SDERungeKutta
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    随机Runge-Kutta法求解随机微分方程组
+理论：
+    对于fn维随机微分方程组，m维Wiener过程W：
+    dy = a(t, y)dt + B(t, y)dW, B为fnxm扩散矩阵
+
+    Ito型：以支撑值代替Milstein法中的导数项(Platen格式)
+    Yj = yn + a(tn, yn)h + Bj(tn, yn)*sqrt(h), j=1,2,...,m
+
+                                m
+    y_(n+1) = yn + a(tn, yn)h + Sum Bj*dWj +
+                               j=1
+                1     m    m
+             ------- Sum  Sum [Bj2(tn, Yj1) - Bj2(tn, yn)]*I(j1,j2)
+             sqrt(h) j1=1 j2=1
+
+    I(j1,j2) = (dWj1*dWj2 - h*delta(j1,j2))/2，与SDEMilstein相同，
+    可交换噪声时强收敛阶1.0，且无需扩散项导数。
+
+    Stratonovich型：随机Heun格式
+    Y = yn + a(tn, yn)h + B(tn, yn)dW
+                    1                               1
+    y_(n+1) = yn + ---[a(tn, yn)+a(t_(n+1), Y)]h + ---[B(tn, yn)+B(t_(n+1), Y)]dW
+                    2                               2
+    可交换噪声时强收敛阶1.0，一般噪声强收敛阶0.5。
+
+    参考 P.E. Kloeden and E. Platen. Numerical Solution of
+         Stochastic Differential Equations. Springer, 1992.
+         ss 11.1.
+------------------------------------------------------
+输入   :
+    fun     漂移项a的第i个分量(计算变量值向量[t y1 ... yfn]', i)
+    gfun    扩散矩阵B的第(i, j)个元素(计算变量值向量, i, j)
+    x0      初值向量，(fn+1)x1，一个t，fn个因变量
+    tend    终止t
+    fn      方程个数
+    m       Wiener过程维数
+    n       积分步数
+    seed    随机数种子
+    ito     true-Ito型；false-Stratonovich型
+输出   :
+    sol     解矩阵，(fn+1)x(n+1)，第一行为t
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+	"math/rand"
+)
+
+// SDERungeKutta 随机Runge-Kutta法求解随机微分方程组
+func SDERungeKutta(fun func(Matrix, int) float64, gfun func(Matrix, int, int) float64,
+	x0 Matrix, tend float64, fn, m, n int, seed int64, ito bool) (Matrix, bool) {
+	/*
+		随机Runge-Kutta法求解随机微分方程组
+		输入   :
+		    fun     漂移项a的第i个分量(计算变量值向量[t y1 ... yfn]', i)
+		    gfun    扩散矩阵B的第(i, j)个元素(计算变量值向量, i, j)
+		    x0      初值向量，(fn+1)x1，一个t，fn个因变量
+		    tend    终止t
+		    fn      方程个数
+		    m       Wiener过程维数
+		    n       积分步数
+		    seed    随机数种子
+		    ito     true-Ito型；false-Stratonovich型
+		输出   :
+		    sol     解矩阵，(fn+1)x(n+1)，第一行为t
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断方程个数是否对应初值个数
+	if x0.Rows != fn+1 {
+		panic("Error in goNum.SDERungeKutta: Quantities of x0 and fn+1 are not equal")
+	}
+	//判断n与m
+	if (n < 1) || (m < 1) {
+		panic("Error in goNum.SDERungeKutta: n or m less than one")
+	}
+
+	sol := ZeroMatrix(fn+1, n+1)
+	var err bool = false
+	h := (tend - x0.Data[0]) / float64(n) //步长
+	sqrth := math.Sqrt(h)
+	r := rand.New(rand.NewSource(seed))
+
+	//把初值赋给sol
+	for i := 0; i < fn+1; i++ {
+		sol.SetMatrix(i, 0, x0.Data[i])
+	}
+
+	temp0 := ZeroMatrix(fn+1, 1)
+	temp1 := ZeroMatrix(fn+1, 1) //支撑值Yj
+	for k := 1; k < n+1; k++ {
+		for i := 0; i < fn+1; i++ {
+			temp0.Data[i] = sol.GetFromMatrix(i, k-1)
+		}
+		B := gMatrix_SDEEulerMaruyama(gfun, temp0, fn, m)
+		dW := wienerInc_SDEEulerMaruyama(r, m, h)
+		a := make([]float64, fn)
+		for i := 0; i < fn; i++ {
+			a[i] = fun(temp0, i)
+		}
+
+		sol.SetMatrix(0, k, temp0.Data[0]+h) //tk
+		soltemp := make([]float64, fn)
+		for i := 0; i < fn; i++ {
+			soltemp[i] = temp0.Data[i+1] + a[i]*h
+			for j := 0; j < m; j++ {
+				soltemp[i] += B.GetFromMatrix(i, j) * dW[j]
+			}
+		}
+		if ito {
+			//支撑值修正项
+			temp1.Data[0] = temp0.Data[0]
+			for j1 := 0; j1 < m; j1++ {
+				for i := 0; i < fn; i++ {
+					temp1.Data[i+1] = temp0.Data[i+1] + a[i]*h + B.GetFromMatrix(i, j1)*sqrth
+				}
+				for j2 := 0; j2 < m; j2++ {
+					I12 := dW[j1] * dW[j2] / 2.0
+					if j1 == j2 {
+						I12 -= h / 2.0
+					}
+					for i := 0; i < fn; i++ {
+						soltemp[i] += (gfun(temp1, i, j2) - B.GetFromMatrix(i, j2)) * I12 / sqrth
+					}
+				}
+			}
+		} else {
+			//Heun校正
+			temp1.Data[0] = temp0.Data[0] + h
+			for i := 0; i < fn; i++ {
+				temp1.Data[i+1] = soltemp[i]
+			}
+			for i := 0; i < fn; i++ {
+				soltemp[i] += (fun(temp1, i) - a[i]) * h / 2.0
+				for j := 0; j < m; j++ {
+					soltemp[i] += (gfun(temp1, i, j) - B.GetFromMatrix(i, j)) * dW[j] / 2.0
+				}
+			}
+		}
+		for i := 0; i < fn; i++ {
+			sol.SetMatrix(i+1, k, soltemp[i])
+		}
+	}
+
+	err = true
+	return sol, err
+}
@@ -0,0 +1,215 @@
+// SimpleIterateAnderson_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    Anderson加速求解向量不动点问题x=G(x)
+理论：
+    残差fk = G(xk) - xk，保留最近mk = min(m, k)步的差分：
+    dXj = x_(j+1) - xj，dFj = f_(j+1) - fj，
+    求最小二乘问题
+    min ||fk - dF*gamma||_2
+    gamma = [gamma_1, ..., gamma_mk]'，则
+    x_(k+1) = xk + beta*fk - (dX + beta*dF)*gamma
+    beta为混合（阻尼）系数，beta = 1时即
+    x_(k+1) = G(xk) - dG*gamma。m = 0时为阻尼简单迭代
+    x_(k+1) = xk + beta*fk。
+
+    最小二乘问题以修正Gram-Schmidt QR分解求解，dF列向量近似
+    线性相关（R对角元相对过小）时舍去最早的差分，以保持数值
+    稳定。Anderson加速等价于多割线拟Newton法，对收敛缓慢的
+    自洽迭代可显著减少迭代次数，且仅需每步计算一次G。
+
+    ||fk||_inf < tol时停止。
+
+    参考 H.F. Walker and P. Ni. Anderson acceleration for
+         fixed-point iterations. SIAM J. Numer. Anal., 2011,
+         49(4): 1715-1735.
+------------------------------------------------------
+输入   :
+    fn      G(x)函数，nx1
+    x0      初值，nx1
+    m       记忆步数，m >= 0
+    beta    混合系数，0 < beta <= 1
+    tol     误差上限
+    N       步数上限
+输出   :
+    sol     解，nx1
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+//修正Gram-Schmidt QR分解求min||f - dF*gamma||，列近似相关时舍去最早的列，
+//返回gamma与舍去的列数
+func lsq_SimpleIterateAnderson(dF [][]float64, f []float64) ([]float64, int) {
+	n := len(f)
+	for drop := 0; drop < len(dF); drop++ {
+		cols := dF[drop:]
+		mk := len(cols)
+		Q := make([][]float64, mk)
+		R := make([][]float64, mk)
+		ok := true
+		for j := 0; j < mk; j++ {
+			Q[j] = append([]float64{}, cols[j]...)
+			R[j] = make([]float64, mk)
+			for i := 0; i < j; i++ {
+				var temp0 float64
+				for k := 0; k < n; k++ {
+					temp0 += Q[i][k] * Q[j][k]
+				}
+				R[i][j] = temp0
+				for k := 0; k < n; k++ {
+					Q[j][k] -= temp0 * Q[i][k]
+				}
+			}
+			R[j][j] = norm2_NLEs_Newton(Q[j])
+			if R[j][j] <= 1e-10*norm2_NLEs_Newton(cols[j]) {
+				ok = false
+				break
+			}
+			for k := 0; k < n; k++ {
+				Q[j][k] /= R[j][j]
+			}
+		}
+		if !ok {
+			continue
+		}
+		//gamma = R^(-1)Q'f
+		gamma := make([]float64, mk)
+		for j := 0; j < mk; j++ {
+			for k := 0; k < n; k++ {
+				gamma[j] += Q[j][k] * f[k]
+			}
+		}
+		for j := mk - 1; j >= 0; j-- {
+			for i := j + 1; i < mk; i++ {
+				gamma[j] -= R[j][i] * gamma[i]
+			}
+			gamma[j] /= R[j][j]
+		}
+		return gamma, drop
+	}
+	return nil, len(dF)
+}
+
+// SimpleIterateAnderson Anderson加速求解向量不动点问题x=G(x)
+func SimpleIterateAnderson(fn func(goNum.Matrix) goNum.Matrix, x0 goNum.Matrix, m int,
+	beta, tol float64, N int) (goNum.Matrix, bool) {
+	/*
+		Anderson加速求解向量不动点问题x=G(x)
+		输入   :
+		    fn      G(x)函数，nx1
+		    x0      初值，nx1
+		    m       记忆步数，m >= 0
+		    beta    混合系数，0 < beta <= 1
+		    tol     误差上限
+		    N       步数上限
+		输出   :
+		    sol     解，nx1
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断x维数
+	if x0.Columns != 1 {
+		panic("Error in goNum.SimpleIterateAnderson: x0 is not a vector")
+	}
+	//判断参数
+	if (m < 0) || (beta <= 0.0) || (beta > 1.0) {
+		panic("Error in goNum.SimpleIterateAnderson: m or beta error")
+	}
+
+	var err bool = false
+	n := x0.Rows
+	sol := goNum.ZeroMatrix(n, 1)
+	copy(sol.Data, x0.Data)
+	dX := make([][]float64, 0, m+1)
+	dF := make([][]float64, 0, m+1)
+	var xold, fold []float64
+	for k := 0; k < N; k++ {
+		G := fn(sol)
+		f := make([]float64, n)
+		for i := 0; i < n; i++ {
+			f[i] = G.Data[i] - sol.Data[i]
+		}
+		maxy, _, _ := goNum.MaxAbs(f)
+		if math.IsNaN(maxy) || math.IsInf(maxy, 0) {
+			return sol, err
+		}
+		//解出
+		if math.Abs(maxy) < tol {
+			err = true
+			return sol, err
+		}
+		//更新差分
+		if (m > 0) && (k > 0) {
+			dx := make([]float64, n)
+			df := make([]float64, n)
+			for i := 0; i < n; i++ {
+				dx[i] = sol.Data[i] - xold[i]
+				df[i] = f[i] - fold[i]
+			}
+			dX = append(dX, dx)
+			dF = append(dF, df)
+			if len(dF) > m {
+				dX = dX[1:]
+				dF = dF[1:]
+			}
+		}
+		xold = append(xold[:0], sol.Data...)
+		fold = f
+
+		xnew := goNum.ZeroMatrix(n, 1)
+		for i := 0; i < n; i++ {
+			xnew.Data[i] = sol.Data[i] + beta*f[i]
+		}
+		if len(dF) > 0 {
+			gamma, drop := lsq_SimpleIterateAnderson(dF, f)
+			dX = dX[drop:]
+			dF = dF[drop:]
+			for j := range gamma {
+				for i := 0; i < n; i++ {
+					xnew.Data[i] -= gamma[j] * (dX[j][i] + beta*dF[j][i])
+				}
+			}
+		}
+		sol = xnew
+	}
+	return sol, err
+}
+
+// -u” + u^3 = 1，u(0) = u(1) = 0，n个内点，Jacobi迭代格式
+func fun88(x goNum.Matrix) goNum.Matrix {
+	n := x.Rows
+	h := 1.0 / float64(n+1)
+	sol := goNum.ZeroMatrix(n, 1)
+	for i := 0; i < n; i++ {
+		l, r := 0.0, 0.0
+		if i > 0 {
+			l = x.Data[i-1]
+		}
+		if i < n-1 {
+			r = x.Data[i+1]
+		}
+		sol.Data[i] = (l + r + h*h*(1.0-x.Data[i]*x.Data[i]*x.Data[i])) / 2.0
+	}
+	return sol
+}
+
+func BenchmarkSimpleIterateAnderson(b *testing.B) {
+	x88 := goNum.ZeroMatrix(20, 1)
+	for i := 0; i < b.N; i++ {
+		goNum.SimpleIterateAnderson(fun88, x88, 5, 1.0, 1e-10, 1000)
+	}
+}
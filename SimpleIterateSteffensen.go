@@ -0,0 +1,90 @@
+// SimpleIterateSteffensen
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    Steffensen法求解类x=g(x)方程的解
+理论：
+    以xk为起点作两步简单迭代，y = g(xk)，z = g(y)，由Aitken
+    外推直接得到下一步：
+                     (y - xk)^2
+    x_(k+1) = xk - ----------------
+                    z - 2y + xk
+    写为xk加修正量的形式，以减小相近数相减的舍入误差。分母为
+    零（迭代已收敛或g在此处为线性）时取x_(k+1) = z。
+    g'(xr) != 1时平方收敛，且对|g'(xr)| >= 1的不动点（简单迭代
+    发散）同样适用。
+
+    |x_(k+1) - xk| < tol时停止。
+
+    参考 John H. Mathews and Kurtis D. Fink. Numerical
+         methods using MATLAB, 4th ed. Pearson
+         Education, 2004. ss 2.5.1.
+------------------------------------------------------
+输入   :
+    fn      g(x)函数，定义为等式右侧部分，左侧为x
+    c       求解初值
+    N       步数上限
+    tol     误差上限
+输出   :
+    sol     解值
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+// SimpleIterateSteffensen Steffensen法求解类x=g(x)方程的解
+func SimpleIterateSteffensen(fn func(float64) float64, c float64,
+	N int, tol float64) (float64, bool) {
+	/*
+		Steffensen法求解类x=g(x)方程的解
+		输入   :
+		    fn      g(x)函数，定义为等式右侧部分，左侧为x
+		    c       求解初值
+		    N       步数上限
+		    tol     误差上限
+		输出   :
+		    sol     解值
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断tol
+	if tol <= 0.0 {
+		panic("Error in goNum.SimpleIterateSteffensen: tol less than or equals to zero")
+	}
+
+	var err bool = false
+	sol := c
+	for i := 0; i < N; i++ {
+		y := fn(sol)
+		if y == sol {
+			err = true
+			return sol, err
+		}
+		z := fn(y)
+		d := z - 2.0*y + sol
+		x := z
+		if d != 0.0 {
+			x = sol - (y-sol)*(y-sol)/d
+		}
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return sol, err
+		}
+		dx := x - sol
+		sol = x
+		//解出
+		if math.Abs(dx) < tol {
+			err = true
+			return sol, err
+		}
+	}
+	return sol, err
+}
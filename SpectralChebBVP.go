@@ -0,0 +1,106 @@
+// SpectralChebBVP
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    Chebyshev配置法求解线性两点边值问题
+理论：
+    对于线性常微分方程：x''(t) = p(t)x'(t) + q(t)x(t) + r(t)
+    两端：alpha*x + beta*x' = gamma
+    beta = 0为Dirichlet边界，否则为Neumann或Robin边界。
+
+    取n+1个Chebyshev-Gauss-Lobatto节点tj(SpectralChebGrid)，
+    D为Chebyshev谱微分矩阵(SpectralChebDiff)，在内部节点上配置：
+    (D^2 - P*D - Q)X = R
+    P、Q为以p(tj)、q(tj)为对角元的对角阵。第一与最后一个方程
+    替换为边界条件：
+    (alpha_l*e0 + beta_l*D_0)X = gamma_l
+    (alpha_r*en + beta_r*D_n)X = gamma_r
+    方程组由列主元消去法(LEs_ECPE)求解。
+    对光滑问题，误差随n指数衰减，n取16～64即可达到机器精度。
+
+    参考 L.N. Trefethen. Spectral Methods in MATLAB. SIAM,
+         2000. ss 7, 13.
+------------------------------------------------------
+输入   :
+    funp, funq, funr 方程系数
+    x0      区间，2x1
+    bc      边界条件，2x3，第一行为左端(alpha, beta, gamma)，
+            第二行为右端
+    n       节点数量减一
+输出   :
+    sol     解矩阵, 2x(n+1)，第一行为节点，第二行为解
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum
+
+// SpectralChebBVP Chebyshev配置法求解线性两点边值问题
+func SpectralChebBVP(funp, funq, funr func(float64) float64, x0, bc Matrix, n int) (Matrix, bool) {
+	/*
+		Chebyshev配置法求解线性两点边值问题
+		输入   :
+		    funp, funq, funr 方程系数
+		    x0      区间，2x1
+		    bc      边界条件，2x3，第一行为左端(alpha, beta, gamma)，
+		            第二行为右端
+		    n       节点数量减一
+		输出   :
+		    sol     解矩阵, 2x(n+1)，第一行为节点，第二行为解
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断n
+	if n < 2 {
+		panic("Error in goNum.SpectralChebBVP: n must greater than one")
+	}
+	//判断边界条件
+	if (bc.Rows != 2) || (bc.Columns != 3) {
+		panic("Error in goNum.SpectralChebBVP: Boundary conditions error")
+	}
+
+	var err bool = false
+	sol := ZeroMatrix(2, n+1)
+	t := SpectralChebGrid(x0, n)
+	D := SpectralChebDiff(x0, n)
+	D2 := DotPruduct(D, D)
+
+	Aa := ZeroMatrix(n+1, n+1)
+	Bb := ZeroMatrix(n+1, 1)
+	for i := 1; i < n; i++ {
+		p := funp(t.Data[i])
+		for j := 0; j < n+1; j++ {
+			Aa.SetMatrix(i, j, D2.GetFromMatrix(i, j)-p*D.GetFromMatrix(i, j))
+		}
+		Aa.SetMatrix(i, i, Aa.GetFromMatrix(i, i)-funq(t.Data[i]))
+		Bb.Data[i] = funr(t.Data[i])
+	}
+	//边界条件
+	for k, i := range []int{0, n} {
+		alpha, beta, gamma := bc.GetFromMatrix(k, 0), bc.GetFromMatrix(k, 1), bc.GetFromMatrix(k, 2)
+		if (alpha == 0.0) && (beta == 0.0) {
+			panic("Error in goNum.SpectralChebBVP: Boundary conditions error")
+		}
+		for j := 0; j < n+1; j++ {
+			Aa.SetMatrix(i, j, beta*D.GetFromMatrix(i, j))
+		}
+		Aa.SetMatrix(i, i, Aa.GetFromMatrix(i, i)+alpha)
+		Bb.Data[i] = gamma
+	}
+
+	xTemp, errTemp := LEs_ECPE(Matrix2ToSlices(Aa), Matrix1ToSlices(Bb))
+	if errTemp != true {
+		panic("Error in goNum.SpectralChebBVP: Solve error")
+	}
+	for j := 0; j < n+1; j++ {
+		sol.SetMatrix(0, j, t.Data[j])
+		sol.SetMatrix(1, j, xTemp[j])
+	}
+
+	err = true
+	return sol, err
+}
@@ -0,0 +1,88 @@
+// SpectralChebDiff_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    Chebyshev谱微分矩阵
+理论：
+    以SpectralChebGrid给出的n+1个Chebyshev-Gauss-Lobatto节点xj
+    作插值多项式p(x)，则节点上的导数值p'(xi) = sum_j D_ij*u_j。
+    由重心权wj = (-1)^j*cj，c0 = cn = 1/2，其余cj = 1：
+            wj      1
+    D_ij = ---- ---------, i != j
+            wi   xi - xj
+
+    D_ii = -sum_(j != i) D_ij
+    对角元由负和给出以减小舍入误差。k阶导数矩阵为D^k。
+    对光滑函数，谱微分误差随n指数衰减。
+
+    参考 L.N. Trefethen. Spectral Methods in MATLAB. SIAM,
+         2000. ss 6.
+------------------------------------------------------
+输入   :
+    x0      区间，2x1
+    n       节点数量减一
+输出   :
+    sol     微分矩阵，(n+1)x(n+1)
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// SpectralChebDiff Chebyshev谱微分矩阵
+func SpectralChebDiff(x0 goNum.Matrix, n int) goNum.Matrix {
+	/*
+		Chebyshev谱微分矩阵
+		输入   :
+		    x0      区间，2x1
+		    n       节点数量减一
+		输出   :
+		    sol     微分矩阵，(n+1)x(n+1)
+	*/
+	//判断n
+	if n < 1 {
+		panic("Error in goNum.SpectralChebDiff: n must greater than zero")
+	}
+
+	x := goNum.SpectralChebGrid(x0, n)
+	//重心权
+	w := make([]float64, n+1)
+	for j := 0; j < n+1; j++ {
+		w[j] = 1.0
+		if j%2 == 1 {
+			w[j] = -1.0
+		}
+		if (j == 0) || (j == n) {
+			w[j] /= 2.0
+		}
+	}
+	sol := goNum.ZeroMatrix(n+1, n+1)
+	for i := 0; i < n+1; i++ {
+		temp0 := 0.0
+		for j := 0; j < n+1; j++ {
+			if j == i {
+				continue
+			}
+			dij := w[j] / w[i] / (x.Data[i] - x.Data[j])
+			sol.SetMatrix(i, j, dij)
+			temp0 += dij
+		}
+		sol.SetMatrix(i, i, -temp0)
+	}
+	return sol
+}
+
+func BenchmarkSpectralChebDiff(b *testing.B) {
+	x79 := goNum.NewMatrix(2, 1, []float64{0.0, 2.0})
+	for i := 0; i < b.N; i++ {
+		goNum.SpectralChebDiff(x79, 64)
+	}
+}
@@ -0,0 +1,60 @@
+// SpectralChebGrid
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    Chebyshev-Gauss-Lobatto节点
+理论：
+    [-1, 1]上的Chebyshev-Gauss-Lobatto节点为Chebyshev多项式
+    T_n(x)的极值点：
+    sj = -cos(j*pi/n) = sin(pi*(2j-n)/(2n)), j = 0, 1, ..., n
+    按递增顺序排列，并线性映射至[a, b]：
+    xj = (a+b)/2 + (b-a)/2*sj
+    节点在两端加密，以其作多项式插值无Runge现象。
+
+    参考 L.N. Trefethen. Spectral Methods in MATLAB. SIAM,
+         2000. ss 5, 6.
+------------------------------------------------------
+输入   :
+    x0      区间，2x1
+    n       节点数量减一
+输出   :
+    sol     节点，(n+1)x1，递增
+------------------------------------------------------
+*/
+
+package goNum
+
+import (
+	"math"
+)
+
+// SpectralChebGrid Chebyshev-Gauss-Lobatto节点
+func SpectralChebGrid(x0 Matrix, n int) Matrix {
+	/*
+		Chebyshev-Gauss-Lobatto节点
+		输入   :
+		    x0      区间，2x1
+		    n       节点数量减一
+		输出   :
+		    sol     节点，(n+1)x1，递增
+	*/
+	//判断n
+	if n < 1 {
+		panic("Error in goNum.SpectralChebGrid: n must greater than zero")
+	}
+
+	a := x0.GetFromMatrix(0, 0)
+	b := x0.GetFromMatrix(1, 0)
+	sol := ZeroMatrix(n+1, 1)
+	for j := 0; j < n+1; j++ {
+		s := math.Sin(math.Pi * float64(2*j-n) / float64(2*n))
+		sol.Data[j] = (a+b)/2.0 + (b-a)/2.0*s
+	}
+	//端点取精确值
+	sol.Data[0] = a
+	sol.Data[n] = b
+	return sol
+}
@@ -0,0 +1,215 @@
+// SpectralChebPDE_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    Chebyshev配置法求解一维含时偏微分方程（直线法）
+理论：
+    对于一维含时偏微分方程：
+     du
+    ---- = F(x, t, u, du/dx, d^2u/dx^2)
+     dt
+
+    u(x, 0) = p(x)
+    左右边界：pb(t)*u + qb(t)*du/dx = gb(t)
+    qb = 0为Dirichlet边界，pb = 0为Neumann边界，否则为Robin边界
+
+    a < x < b, 0 < t < T
+
+    x方向取m+1个Chebyshev-Gauss-Lobatto节点xi(SpectralChebGrid)，
+    D为Chebyshev谱微分矩阵(SpectralChebDiff)，内部节点u1～u_(m-1)
+    为未知量：
+     dui
+    ----- = F(xi, t, ui, (DU)_i, (D^2U)_i), i = 1, ..., m-1
+     dt
+    两端节点值由边界条件给出的2x2线性方程组求得：
+    pl*u0 + ql*(D_00*u0 + D_0m*um + sum_j D_0j*uj) = gl
+    pr*um + qr*(D_m0*u0 + D_mm*um + sum_j D_mj*uj) = gr
+
+    所得常微分方程组由指定的ODE求解器（如RK44、RK22、ODEAdamsEX、
+    ODEAdamsBashforthMoultonSys等）在t方向积分。D与D^2的最大特征值
+    分别约为O(m^2)与O(m^4)，显式求解器的步长须相应取小。
+
+    参考 L.N. Trefethen. Spectral Methods in MATLAB. SIAM,
+         2000. ss 10.
+------------------------------------------------------
+输入   :
+    funF    右端函数F(x, t, u, ux, uxx)
+    funp    初值函数p(x)
+    bcl     左边界函数(t)，返回(pb, qb, gb)
+    bcr     右边界函数(t)，返回(pb, qb, gb)
+    x0      求解范围，2x2
+    m, n    x方向节点数量减一与t方向步数
+    solver  ODE求解器(方程, 初值向量, 终止t, 方程个数, 步数)
+输出   :
+    sol     解矩阵，(m+1)x(n+1)，第i行对应节点xi
+    err     解出标志：false-未解出或达到步数上限；
+                     true-全部解出
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// SpectralChebPDE Chebyshev配置法求解一维含时偏微分方程（直线法）
+func SpectralChebPDE(funF func(float64, float64, float64, float64, float64) float64,
+	funp func(float64) float64, bcl, bcr func(float64) (float64, float64, float64),
+	x0 goNum.Matrix, m, n int,
+	solver func(func(goNum.Matrix, int) float64, goNum.Matrix, float64, int, int) (goNum.Matrix, bool)) (goNum.Matrix, bool) {
+	/*
+		Chebyshev配置法求解一维含时偏微分方程（直线法）
+		输入   :
+		    funF    右端函数F(x, t, u, ux, uxx)
+		    funp    初值函数p(x)
+		    bcl     左边界函数(t)，返回(pb, qb, gb)
+		    bcr     右边界函数(t)，返回(pb, qb, gb)
+		    x0      求解范围，2x2
+		    m, n    x方向节点数量减一与t方向步数
+		    solver  ODE求解器(方程, 初值向量, 终止t, 方程个数, 步数)
+		输出   :
+		    sol     解矩阵，(m+1)x(n+1)，第i行对应节点xi
+		    err     解出标志：false-未解出或达到步数上限；
+		                     true-全部解出
+	*/
+	//判断网格数量
+	if (m < 2) || (n < 1) {
+		panic("Error in goNum.SpectralChebPDE: Grid numbers error")
+	}
+
+	xr := goNum.NewMatrix(2, 1, []float64{x0.GetFromMatrix(0, 0), x0.GetFromMatrix(1, 0)})
+	t0 := x0.GetFromMatrix(0, 1)
+	T := x0.GetFromMatrix(1, 1)
+	x := goNum.SpectralChebGrid(xr, m)
+	D := goNum.SpectralChebDiff(xr, m)
+	fn := m - 1
+
+	//由内部节点值组装全部节点值
+	u := make([]float64, m+1)
+	assemble := func(xy goNum.Matrix) {
+		t := xy.Data[0]
+		copy(u[1:m], xy.Data[1:fn+1])
+		pl, ql, gl := bcl(t)
+		pr, qr, gr := bcr(t)
+		sl, sr := 0.0, 0.0
+		for j := 1; j < m; j++ {
+			sl += D.GetFromMatrix(0, j) * u[j]
+			sr += D.GetFromMatrix(m, j) * u[j]
+		}
+		a11 := pl + ql*D.GetFromMatrix(0, 0)
+		a12 := ql * D.GetFromMatrix(0, m)
+		a21 := qr * D.GetFromMatrix(m, 0)
+		a22 := pr + qr*D.GetFromMatrix(m, m)
+		b1 := gl - ql*sl
+		b2 := gr - qr*sr
+		det := a11*a22 - a12*a21
+		if det == 0.0 {
+			panic("Error in goNum.SpectralChebPDE: Boundary condition error")
+		}
+		u[0] = (b1*a22 - a12*b2) / det
+		u[m] = (a11*b2 - a21*b1) / det
+	}
+
+	//右端项，对同一计算变量值向量缓存
+	last := make([]float64, fn+1)
+	dudt := make([]float64, fn)
+	ux := make([]float64, m+1)
+	cached := false
+	rhs := func(xy goNum.Matrix) {
+		if cached {
+			same := true
+			for i := 0; i < fn+1; i++ {
+				if last[i] != xy.Data[i] {
+					same = false
+					break
+				}
+			}
+			if same {
+				return
+			}
+		}
+		copy(last, xy.Data)
+		cached = true
+		assemble(xy)
+		t := xy.Data[0]
+		for i := 0; i < m+1; i++ {
+			temp0 := 0.0
+			for j := 0; j < m+1; j++ {
+				temp0 += D.GetFromMatrix(i, j) * u[j]
+			}
+			ux[i] = temp0
+		}
+		for i := 1; i < m; i++ {
+			uxx := 0.0
+			for j := 0; j < m+1; j++ {
+				uxx += D.GetFromMatrix(i, j) * ux[j]
+			}
+			dudt[i-1] = funF(x.Data[i], t, u[i], ux[i], uxx)
+		}
+	}
+	fun := func(xy goNum.Matrix, i int) float64 {
+		rhs(xy)
+		return dudt[i]
+	}
+
+	//初值
+	xy0 := goNum.ZeroMatrix(fn+1, 1)
+	xy0.Data[0] = t0
+	for i := 1; i < m; i++ {
+		xy0.Data[i] = funp(x.Data[i])
+	}
+
+	solt, err := solver(fun, xy0, T, fn, n)
+	if solt.Columns != n+1 {
+		panic("Error in goNum.SpectralChebPDE: Steps of solver are not equal to n")
+	}
+
+	//解矩阵
+	sol := goNum.ZeroMatrix(m+1, n+1)
+	for j := 0; j < n+1; j++ {
+		for i := 0; i < fn+1; i++ {
+			xy0.Data[i] = solt.GetFromMatrix(i, j)
+		}
+		assemble(xy0)
+		for i := 0; i < m+1; i++ {
+			sol.SetMatrix(i, j, u[i])
+		}
+	}
+	return sol, err
+}
+
+//热传导方程u_t = u_xx，精确解u = exp(-pi^2*t/4)*cos(pi*x/2)
+func fun80(x, t float64) float64 {
+	return math.Exp(-math.Pi*math.Pi*t/4.0) * math.Cos(math.Pi*x/2.0)
+}
+
+func fun80_F(x, t, u, ux, uxx float64) float64 {
+	return uxx
+}
+
+func fun80_p(x float64) float64 {
+	return fun80(x, 0.0)
+}
+
+func fun80_l(t float64) (float64, float64, float64) {
+	return 1.0, 0.0, fun80(-1.0, t)
+}
+
+func fun80_r(t float64) (float64, float64, float64) {
+	ux := -math.Pi / 2.0 * math.Exp(-math.Pi*math.Pi*t/4.0)
+	return 1.0, 1.0, fun80(1.0, t) + ux
+}
+
+func BenchmarkSpectralChebPDE(b *testing.B) {
+	x80 := goNum.NewMatrix(2, 2, []float64{-1.0, 0.0, 1.0, 0.5})
+	for i := 0; i < b.N; i++ {
+		goNum.SpectralChebPDE(fun80_F, fun80_p, fun80_l, fun80_r, x80, 12, 2000, goNum.RK44)
+	}
+}
@@ -0,0 +1,108 @@
+// SpectralFourierDiff_test
+/*
+------------------------------------------------------
+作者   : Black Ghost
+日期   : 2026-10-19
+版本   : 0.0.0
+------------------------------------------------------
+    周期区间上的Fourier谱微分矩阵（一阶、二阶）
+理论：
+    周期区间[a, b)，L = b-a，取n个等距节点xj = a + j*L/n，
+    j = 0, 1, ..., n-1，以三角插值多项式求导。记h = 2pi/n，
+    s = (i-j)*h/2，对[0, 2pi)上的节点，i != j时：
+    n为偶数：
+    D1_ij = (-1)^(i-j)/2*cot(s)，D1_ii = 0
+    D2_ij = -(-1)^(i-j)/(2sin^2(s))，D2_ii = -pi^2/(3h^2) - 1/6
+    n为奇数：
+    D1_ij = (-1)^(i-j)/2*csc(s)，D1_ii = 0
+    D2_ij = -(-1)^(i-j)/2*csc(s)cot(s)，D2_ii = -pi^2/(3h^2) + 1/12
+    映射至[a, b)时k阶导数矩阵乘以(2pi/L)^k。
+    对光滑周期函数，谱微分误差随n指数衰减。
+
+    参考 L.N. Trefethen. Spectral Methods in MATLAB. SIAM,
+         2000. ss 3.
+------------------------------------------------------
+输入   :
+    x0      周期区间，2x1
+    n       节点数量
+    k       导数阶数，1或2
+输出   :
+    sol     微分矩阵，nxn
+------------------------------------------------------
+*/
+
+package goNum_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chfenger/goNum"
+)
+
+// SpectralFourierDiff 周期区间上的Fourier谱微分矩阵（一阶、二阶）
+func SpectralFourierDiff(x0 goNum.Matrix, n, k int) goNum.Matrix {
+	/*
+		周期区间上的Fourier谱微分矩阵（一阶、二阶）
+		输入   :
+		    x0      周期区间，2x1
+		    n       节点数量
+		    k       导数阶数，1或2
+		输出   :
+		    sol     微分矩阵，nxn
+	*/
+	//判断n
+	if n < 2 {
+		panic("Error in goNum.SpectralFourierDiff: n must greater than one")
+	}
+	//判断k
+	if (k != 1) && (k != 2) {
+		panic("Error in goNum.SpectralFourierDiff: k is not 1 or 2")
+	}
+
+	L := x0.GetFromMatrix(1, 0) - x0.GetFromMatrix(0, 0)
+	h := 2.0 * math.Pi / float64(n)
+	scale := math.Pow(2.0*math.Pi/L, float64(k))
+	even := n%2 == 0
+	sol := goNum.ZeroMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			var temp0 float64
+			if i == j {
+				if k == 2 {
+					temp0 = -math.Pi * math.Pi / (3.0 * h * h)
+					if even {
+						temp0 -= 1.0 / 6.0
+					} else {
+						temp0 += 1.0 / 12.0
+					}
+				}
+			} else {
+				sgn := 1.0
+				if (i-j)%2 != 0 {
+					sgn = -1.0
+				}
+				s := float64(i-j) * h / 2.0
+				switch {
+				case (k == 1) && even:
+					temp0 = sgn / 2.0 / math.Tan(s)
+				case k == 1:
+					temp0 = sgn / 2.0 / math.Sin(s)
+				case even:
+					temp0 = -sgn / (2.0 * math.Sin(s) * math.Sin(s))
+				default:
+					temp0 = -sgn / 2.0 / math.Sin(s) / math.Tan(s)
+				}
+			}
+			sol.SetMatrix(i, j, temp0*scale)
+		}
+	}
+	return sol
+}
+
+func BenchmarkSpectralFourierDiff(b *testing.B) {
+	x81 := goNum.NewMatrix(2, 1, []float64{0.0, 2.0 * math.Pi})
+	for i := 0; i < b.N; i++ {
+		goNum.SpectralFourierDiff(x81, 32, 2)
+	}
+}